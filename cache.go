@@ -2,12 +2,18 @@ package youscore
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Cache defines the interface for caching HTTP responses.
@@ -16,65 +22,225 @@ import (
 type Cache interface {
 	// Get retrieves a cached response for the given cache key.
 	// url is the raw request URL, provided for custom per-route handling (e.g. different TTLs).
-	// key is a hash derived from the full request (method, URL, headers, body).
+	// key is the Fingerprint of the request (method, URL, body).
 	// If the key is not found, ok must be false.
 	Get(url string, key string) (resp CachedResponse, ok bool)
 
 	// Set stores a response for the given cache key.
 	// url is the raw request URL, provided for custom per-route handling.
-	// key is a hash derived from the full request (method, URL, headers, body).
+	// key is the Fingerprint of the request (method, URL, body).
 	Set(url string, key string, resp CachedResponse)
 }
 
+// ContextCache is a context-aware Cache, for backends that need deadlines and can fail (Redis, SQL, etc.).
+// Use it with WithContextCache. Errors are treated as cache misses by the client, see WithCacheErrorHook.
+type ContextCache interface {
+	// Get retrieves a cached response for the given cache key.
+	// If the key is not found, ok must be false and err nil.
+	Get(ctx context.Context, url string, key string) (resp CachedResponse, ok bool, err error)
+
+	// Set stores a response for the given cache key.
+	Set(ctx context.Context, url string, key string, resp CachedResponse) error
+
+	// Delete removes the entry of the given cache key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, url string, key string) error
+}
+
+// AdaptCache turns a Cache into a ContextCache.
+// Delete is supported if the Cache has a Delete(url, key string) method,
+// otherwise it returns errors.ErrUnsupported.
+func AdaptCache(cache Cache) ContextCache {
+	return cacheAdapter{cache}
+}
+
+type cacheAdapter struct {
+	cache Cache
+}
+
+func (a cacheAdapter) Get(_ context.Context, url string, key string) (CachedResponse, bool, error) {
+	resp, ok := a.cache.Get(url, key)
+	return resp, ok, nil
+}
+
+func (a cacheAdapter) Set(_ context.Context, url string, key string, resp CachedResponse) error {
+	a.cache.Set(url, key, resp)
+	return nil
+}
+
+func (a cacheAdapter) Delete(_ context.Context, url string, key string) error {
+	d, ok := a.cache.(interface{ Delete(url string, key string) })
+	if !ok {
+		return errors.ErrUnsupported
+	}
+	d.Delete(url, key)
+	return nil
+}
+
 // CachedResponse holds the data needed to reconstruct an HTTP response from cache.
 type CachedResponse struct {
 	StatusCode int
 	Header     http.Header
 	Body       []byte
+	// Metadata describes the response, e.g. when it was fetched and which entity it belongs to.
+	Metadata CacheMetadata
+}
+
+// CacheOption configures WithCache.
+type CacheOption func(*cachingDoer)
+
+// CachePolicy reports whether a response may be stored in the cache.
+type CachePolicy func(req *http.Request, resp *http.Response) bool
+
+// WithCacheErrorHook sets a function that is called with every error of the ContextCache.
+// Failed lookups are treated as misses and failed stores are skipped, so the request itself never fails.
+func WithCacheErrorHook(fn func(ctx context.Context, err error)) CacheOption {
+	return func(d *cachingDoer) {
+		d.onError = fn
+	}
+}
+
+// WithCachePolicy overrides DefaultCachePolicy.
+func WithCachePolicy(policy CachePolicy) CacheOption {
+	return func(d *cachingDoer) {
+		d.policy = policy
+	}
+}
+
+// DefaultCachePolicy only stores final responses: 200 and 404.
+// 202 "Update in progress", 429 and 5xx responses are never stored,
+// and neither are responses of operations that are not Cacheable (rate limits, async job starts).
+func DefaultCachePolicy(req *http.Request, resp *http.Response) bool {
+	if op, ok := LookupOperation(req.Method, req.URL.EscapedPath()); ok && !op.Cacheable {
+		return false
+	}
+	return resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNotFound
 }
 
 // WithCache returns a ClientOption that wraps the underlying HTTP client
 // with a caching layer. All requests are passed to the Cache implementation,
-// which decides what to cache via its Get/Set methods.
-func WithCache(cache Cache) ClientOption {
+// responses are stored if the CachePolicy allows it (see DefaultCachePolicy).
+func WithCache(cache Cache, opts ...CacheOption) ClientOption {
+	return WithContextCache(AdaptCache(cache), opts...)
+}
+
+// WithContextCache is like WithCache, for a ContextCache.
+func WithContextCache(cache ContextCache, opts ...CacheOption) ClientOption {
 	return func(c *Client) error {
 		inner := c.Client
 		if inner == nil {
 			inner = &http.Client{}
 		}
-		c.Client = &cachingDoer{
-			inner: inner,
-			cache: cache,
+		d := &cachingDoer{
+			inner:  inner,
+			cache:  cache,
+			policy: DefaultCachePolicy,
+		}
+		for _, o := range opts {
+			o(d)
 		}
+		c.Client = d
 		return nil
 	}
 }
 
 type cachingDoer struct {
-	inner HttpRequestDoer
-	cache Cache
+	inner   HttpRequestDoer
+	cache   ContextCache
+	policy  CachePolicy
+	onError func(ctx context.Context, err error)
+	stale   StalePolicy
+
+	refreshing   sync.Map // keys that are being revalidated in the background
+	onRevalidate func(rawURL string, err error)
 }
 
-// cacheKey builds a deterministic hash from method, URL, and body.
+// Fingerprint returns the canonical cache key of a request, as used by WithCache.
+// It can be used to compute keys for pre-warming or invalidating a cache:
+//
+//	req, _ := youscore.NewGetV1UsrContractorCodeRequest(youscore.ServerURL, code, nil)
+//	key, _ := youscore.Fingerprint(req)
+//
+// Equivalent requests get the same fingerprint: the method, host and the literal path segments
+// of the operation are compared case-insensitively, query parameters are sorted and auth
+// parameters (e.g. apiKey) dropped. Path parameters (e.g. a resultId) are compared as is.
+// Headers, including Authorization, are not part of the fingerprint. The body is, and is
+// replaced with a replayable copy.
+func Fingerprint(req *http.Request) (string, error) {
+	key, _, err := cacheKey(req)
+	return key, err
+}
+
+// cacheKey builds the fingerprint of a request, and returns the buffered body.
 func cacheKey(req *http.Request) (string, []byte, error) {
 	h := sha256.New()
-	h.Write([]byte(req.Method))
-	h.Write([]byte(req.URL.String()))
+	h.Write([]byte(strings.ToUpper(req.Method)))
+	h.Write([]byte{0})
+	h.Write([]byte(canonicalURL(req.Method, req.URL)))
+	h.Write([]byte{0})
 
-	var bodyBytes []byte
-	if req.Body != nil {
-		var err error
-		bodyBytes, err = io.ReadAll(req.Body)
-		if err != nil {
-			return "", nil, err
-		}
-		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-		h.Write(bodyBytes)
+	bodyBytes, err := bufferBody(req)
+	if err != nil {
+		return "", nil, err
 	}
+	h.Write(bodyBytes)
 
 	return hex.EncodeToString(h.Sum(nil)), bodyBytes, nil
 }
 
+// canonicalURL returns the URL with a lower case host, the literal path segments spelled
+// as in the operation, sorted query parameters, and without sensitive parameters.
+func canonicalURL(method string, u *url.URL) string {
+	q := u.Query()
+	for param := range q {
+		if isSensitiveParam(param) {
+			q.Del(param)
+		}
+	}
+	c := url.URL{
+		Scheme:   strings.ToLower(u.Scheme),
+		Host:     strings.ToLower(u.Host),
+		Path:     canonicalPath(method, u.Path),
+		RawQuery: q.Encode(), // sorted by key
+	}
+	return c.String()
+}
+
+// canonicalPath spells the literal segments of a path as in the template of its operation,
+// keeping the path parameters as they are. Paths of unknown operations are returned unchanged.
+func canonicalPath(method string, path string) string {
+	op, ok := LookupOperation(strings.ToUpper(method), path)
+	if !ok {
+		return path
+	}
+	prefix := ""
+	if i := strings.Index(path, "/v1/"); i > 0 {
+		prefix, path = path[:i], path[i:]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, part := range strings.Split(strings.Trim(op.Path, "/"), "/") {
+		if !strings.HasPrefix(part, "{") {
+			segments[i] = part
+		}
+	}
+	return prefix + "/" + strings.Join(segments, "/")
+}
+
+// bufferBody reads the request body into memory and replaces it with a replayable copy.
+// It returns nil if the request has no body.
+func bufferBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	bodyBytes, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	return bodyBytes, nil
+}
+
 // sensitiveQueryParams lists query parameter names that may contain secrets
 // and must be stripped from URLs before passing to the Cache interface.
 var sensitiveQueryParams = []string{"apikey", "api_key", "api-key", "token", "access_token", "authorization"}
@@ -107,43 +273,117 @@ func isSensitiveParam(name string) bool {
 }
 
 func (d *cachingDoer) Do(req *http.Request) (*http.Response, error) {
-	key, _, err := cacheKey(req)
+	key, reqBody, err := cacheKey(req)
 	if err != nil {
 		return nil, err
 	}
 
+	ctx := req.Context()
 	rawURL := sanitizeURL(req.URL.String())
+	mode := cacheModeFromContext(ctx)
 
-	if cached, ok := d.cache.Get(rawURL, key); ok {
-		return &http.Response{
-			StatusCode: cached.StatusCode,
-			Header:     cached.Header,
-			Body:       io.NopCloser(bytes.NewReader(cached.Body)),
-			Request:    req,
-		}, nil
+	if mode == NoCache {
+		return d.inner.Do(req)
+	}
+
+	var (
+		cached CachedResponse
+		ok     bool
+	)
+	if mode != RefreshCache {
+		cached, ok, err = d.cache.Get(ctx, rawURL, key)
+		if err != nil {
+			d.reportError(ctx, fmt.Errorf("cache get: %w", err))
+			ok = false
+		}
+	}
+
+	// a stale entry is either served while it is refreshed in the background,
+	// or kept as a fallback for a failing refresh
+	var fallback *CachedResponse
+	if ok {
+		stale := d.isStale(cached)
+		switch {
+		case !stale || mode == CacheOnly:
+			return d.cachedResponse(req, cached, stale), nil
+		case d.stale.WhileRevalidate:
+			d.revalidate(req, reqBody, rawURL, key)
+			return d.cachedResponse(req, cached, true), nil
+		case d.stale.OnError:
+			fallback = &cached
+		}
+	}
+
+	if mode == CacheOnly {
+		return nil, &CacheMissError{URL: rawURL}
 	}
 
 	resp, err := d.inner.Do(req)
+	if fallback != nil && upstreamFailed(ctx, resp, err) {
+		if err == nil {
+			resp.Body.Close()
+		}
+		return d.cachedResponse(req, *fallback, true), nil
+	}
 	if err != nil {
 		return resp, err
 	}
+	return d.store(req, rawURL, key, resp)
+}
+
+// cachedResponse reconstructs an HTTP response from a cache entry.
+func (d *cachingDoer) cachedResponse(req *http.Request, cached CachedResponse, stale bool) *http.Response {
+	if rec := callRecordFromContext(req.Context()); rec != nil {
+		rec.fromCache = true
+	}
+
+	header := cached.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	if !cached.Metadata.FetchedAt.IsZero() {
+		age := max(time.Since(cached.Metadata.FetchedAt), 0)
+		header.Set("Age", strconv.Itoa(int(age.Seconds())))
+	}
+	if stale {
+		header.Set(StaleHeader, "true")
+	}
+
+	return &http.Response{
+		StatusCode: cached.StatusCode,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(cached.Body)),
+		Request:    req,
+	}
+}
 
+// store reads the response body and stores the response if the CachePolicy allows it.
+func (d *cachingDoer) store(req *http.Request, rawURL string, key string, resp *http.Response) (*http.Response, error) {
 	body, err := io.ReadAll(resp.Body)
 	resp.Body.Close()
 	if err != nil {
 		return resp, err
 	}
 
-	skipCache := strings.Contains(req.URL.String(), "/rateLimits")
-
-	if !skipCache {
-		d.cache.Set(sanitizeURL(req.URL.String()), key, CachedResponse{
+	if d.policy(req, resp) {
+		ctx := req.Context()
+		err := d.cache.Set(ctx, rawURL, key, CachedResponse{
 			StatusCode: resp.StatusCode,
 			Header:     resp.Header.Clone(),
 			Body:       bytes.Clone(body),
+			Metadata:   newCacheMetadata(req, body, time.Now()),
 		})
+		if err != nil {
+			d.reportError(ctx, fmt.Errorf("cache set: %w", err))
+		}
 	}
 
 	resp.Body = io.NopCloser(bytes.NewReader(body))
 	return resp, nil
 }
+
+func (d *cachingDoer) reportError(ctx context.Context, err error) {
+	if d.onError != nil {
+		d.onError(ctx, err)
+	}
+}
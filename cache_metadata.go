@@ -0,0 +1,106 @@
+package youscore
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CacheMetadata describes a cached response, so that Cache implementations can
+// invalidate or audit entries per entity without parsing URLs.
+type CacheMetadata struct {
+	// FetchedAt is when the response was fetched from the API. It may be zero for entries of older versions.
+	FetchedAt time.Time `json:"fetchedAt"`
+	// Operation is the name of the operation, e.g. GetV1UsrContractorCode. Empty if the request matched no operation.
+	Operation string `json:"operation,omitempty"`
+	// APIType is the information type of the operation.
+	APIType APIType `json:"apiType,omitempty"`
+	// ContractorCode is the EDRPOU code of the legal entity, from the path or query.
+	ContractorCode string `json:"contractorCode,omitempty"`
+	// INN is the taxpayer identification number of the individual, from the query.
+	INN string `json:"inn,omitempty"`
+	// Passport is the passport number or id of the individual, from the query.
+	Passport string `json:"passport,omitempty"`
+	// ResultID is the id of an async result.
+	ResultID string `json:"resultId,omitempty"`
+	// ActualDate is the actualDate of the payload, zero if it has none.
+	ActualDate time.Time `json:"actualDate,omitzero"`
+}
+
+// newCacheMetadata describes the response to req, with the given response body.
+func newCacheMetadata(req *http.Request, body []byte, fetchedAt time.Time) CacheMetadata {
+	meta := CacheMetadata{
+		FetchedAt:  fetchedAt,
+		ActualDate: payloadActualDate(body),
+	}
+
+	op, ok := LookupOperation(req.Method, req.URL.EscapedPath())
+	if !ok {
+		return meta
+	}
+	meta.Operation = op.Name
+	meta.APIType = op.InfoType
+
+	params := op.pathParams(req.URL.EscapedPath())
+	query := req.URL.Query()
+	meta.ContractorCode = firstNonEmpty(params["contractorCode"], firstQueryParam(query, contractorCodeParams))
+	meta.INN = firstQueryParam(query, innParams)
+	meta.Passport = firstQueryParam(query, passportParams)
+	if op.Async == AsyncResult {
+		meta.ResultID = firstNonEmpty(params["resultId"], params["journalId"], params["id"])
+	}
+	return meta
+}
+
+// The query parameters that identify the entity of a request, as named by the operations.
+// Path parameters are not listed: only contractorCode identifies an entity, other
+// path parameters (e.g. the access code of usrAdministrativeServicesResults) do not.
+var (
+	contractorCodeParams = []string{"contractorCode", "code"}
+	innParams            = []string{"INN", "Code"}
+	passportParams       = []string{"Passport"}
+)
+
+// firstQueryParam returns the value of the first of names that is set in query.
+func firstQueryParam(query url.Values, names []string) string {
+	for _, name := range names {
+		if v := query.Get(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// actualDateLayouts are the layouts of actualDate seen in payloads, with and without a time zone.
+var actualDateLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02"}
+
+// payloadActualDate returns the top level actualDate of a JSON payload, if present.
+func payloadActualDate(body []byte) time.Time {
+	var payload struct {
+		ActualDate string `json:"actualDate"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return time.Time{}
+	}
+	return parseActualDate(payload.ActualDate)
+}
+
+// parseActualDate parses a date of a payload in any of the actualDateLayouts, zero if it is empty or invalid.
+func parseActualDate(s string) time.Time {
+	for _, layout := range actualDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
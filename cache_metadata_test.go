@@ -0,0 +1,138 @@
+package youscore
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewCacheMetadata(t *testing.T) {
+	fetchedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name string
+		url  string
+		body string
+		want CacheMetadata
+	}{
+		{
+			name: "contractor code in path",
+			url:  ServerURL + "/v1/usr/08215600?showCurrentData=true",
+			body: `{"actualDate":"2024-05-06T07:08:09"}`,
+			want: CacheMetadata{
+				Operation:      "GetV1UsrContractorCode",
+				APIType:        APITypeData,
+				ContractorCode: "08215600",
+				ActualDate:     time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+			},
+		},
+		{
+			name: "inn in query",
+			url:  ServerURL + "/v1/individualsRnboSanctions?INN=1234567890",
+			body: `{"resultId":"abc"}`,
+			want: CacheMetadata{
+				Operation: "GetV1IndividualsRnboSanctions",
+				APIType:   APITypeData,
+				INN:       "1234567890",
+			},
+		},
+		{
+			name: "code in query",
+			url:  ServerURL + "/v1/usrDocuments/usrStatutFile?code=08215600",
+			want: CacheMetadata{
+				Operation:      "GetV1UsrDocumentsUsrStatutFile",
+				APIType:        APITypeAnalysis,
+				ContractorCode: "08215600",
+			},
+		},
+		{
+			name: "contractor code in query",
+			url:  ServerURL + "/v1/sanctions?contractorCode=08215600",
+			want: CacheMetadata{
+				Operation:      "GetV1Sanctions",
+				APIType:        APITypeAnalysis,
+				ContractorCode: "08215600",
+			},
+		},
+		{
+			name: "inn as code",
+			url:  ServerURL + "/v1/myrotvorets?Code=1234567890",
+			want: CacheMetadata{
+				Operation: "GetV1Myrotvorets",
+				APIType:   APITypeData,
+				INN:       "1234567890",
+			},
+		},
+		{
+			name: "passport in query",
+			url:  ServerURL + "/v1/individualsRnboSanctions?Passport=AB123456",
+			want: CacheMetadata{
+				Operation: "GetV1IndividualsRnboSanctions",
+				APIType:   APITypeData,
+				Passport:  "AB123456",
+			},
+		},
+		{
+			name: "access code in path",
+			url:  ServerURL + "/v1/usrAdministrativeServicesResults/A1B2",
+			want: CacheMetadata{
+				Operation: "GetV1UsrAdministrativeServicesResultsCode",
+				APIType:   APITypeAnalysis,
+			},
+		},
+		{
+			name: "result id",
+			url:  ServerURL + "/v1/tenders/risks/j-1",
+			body: `[]`,
+			want: CacheMetadata{
+				Operation: "GetV1TendersRisksJournalId",
+				APIType:   APITypeAnalysis,
+				ResultID:  "j-1",
+			},
+		},
+		{
+			name: "unknown operation",
+			url:  ServerURL + "/v2/unknown",
+			body: `{"actualDate":"2024-05-06T07:08:09+03:00"}`,
+			want: CacheMetadata{
+				ActualDate: time.Date(2024, 5, 6, 4, 8, 9, 0, time.UTC),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := newCacheMetadata(req, []byte(tt.body), fetchedAt)
+
+			tt.want.FetchedAt = fetchedAt
+			if !got.ActualDate.Equal(tt.want.ActualDate) {
+				t.Errorf("ActualDate = %v, want %v", got.ActualDate, tt.want.ActualDate)
+			}
+			got.ActualDate, tt.want.ActualDate = time.Time{}, time.Time{}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCache_Metadata(t *testing.T) {
+	cache := newMapCache()
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(&fakeDoer{}), WithCache(cache))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range cache.store {
+		if entry.Metadata.ContractorCode != "08215600" || entry.Metadata.FetchedAt.IsZero() {
+			t.Fatalf("unexpected metadata: %+v", entry.Metadata)
+		}
+		return
+	}
+	t.Fatal("expected the response to be cached")
+}
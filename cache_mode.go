@@ -0,0 +1,54 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrCacheMiss is returned in CacheOnly mode when the response is not cached.
+var ErrCacheMiss = errors.New("youscore: cache miss")
+
+// CacheMode controls how WithCache treats a single request, see WithCacheMode.
+type CacheMode int
+
+const (
+	// CacheDefault reads from and writes to the cache.
+	CacheDefault CacheMode = iota
+	// NoCache bypasses the cache: the API is always called and the response is not stored.
+	NoCache
+	// RefreshCache always calls the API and stores the fresh response.
+	RefreshCache
+	// CacheOnly never calls the API. Requests that are not cached fail with a *CacheMissError.
+	CacheOnly
+)
+
+// CacheMissError is returned in CacheOnly mode when the response is not cached.
+// It matches ErrCacheMiss with errors.Is.
+type CacheMissError struct {
+	// URL is the sanitized request URL.
+	URL string
+}
+
+func (e *CacheMissError) Error() string {
+	return "youscore: cache miss: " + e.URL
+}
+
+func (e *CacheMissError) Is(target error) bool {
+	return target == ErrCacheMiss
+}
+
+type cacheModeCtxKey struct{}
+
+// WithCacheMode returns a context that makes WithCache use the given mode for requests made with it:
+//
+//	// re-check sanctions right before signing
+//	ctx = youscore.WithCacheMode(ctx, youscore.RefreshCache)
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return context.WithValue(ctx, cacheModeCtxKey{}, mode)
+}
+
+// cacheModeFromContext returns the CacheMode of ctx, CacheDefault if none is set.
+func cacheModeFromContext(ctx context.Context) CacheMode {
+	mode, _ := ctx.Value(cacheModeCtxKey{}).(CacheMode)
+	return mode
+}
@@ -0,0 +1,60 @@
+package youscore
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithCacheMode(t *testing.T) {
+	fake := &fakeDoer{}
+	cache := newMapCache()
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(fake),
+		WithCache(cache),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := t.Context()
+	get := func(mode CacheMode) error {
+		_, err := cl.GetV1UsrContractorCodeWithResponse(WithCacheMode(ctx, mode), "08215600", nil)
+		return err
+	}
+
+	// nothing cached yet
+	err = get(CacheOnly)
+	var missErr *CacheMissError
+	if !errors.As(err, &missErr) || !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected CacheMissError, got %v", err)
+	}
+	if fake.calls != 0 {
+		t.Fatalf("expected no calls in CacheOnly mode, got %d", fake.calls)
+	}
+
+	// NoCache neither reads nor writes
+	if err := get(NoCache); err != nil {
+		t.Fatal(err)
+	}
+	if len(cache.store) != 0 {
+		t.Fatalf("expected nothing to be stored, got %d entries", len(cache.store))
+	}
+
+	// the default mode stores the response, which CacheOnly then serves
+	if err := get(CacheDefault); err != nil {
+		t.Fatal(err)
+	}
+	if err := get(CacheOnly); err != nil {
+		t.Fatal(err)
+	}
+	if fake.calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", fake.calls)
+	}
+
+	// RefreshCache always calls the API, even if cached
+	if err := get(RefreshCache); err != nil {
+		t.Fatal(err)
+	}
+	if fake.calls != 3 || len(cache.store) != 1 {
+		t.Fatalf("expected 3 calls and 1 entry, got %d calls and %d entries", fake.calls, len(cache.store))
+	}
+}
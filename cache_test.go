@@ -1,6 +1,8 @@
 package youscore
 
 import (
+	"context"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
@@ -142,9 +144,11 @@ func TestWithCache_PostRequestCached(t *testing.T) {
 	fake := &fakeDoer{}
 	cache := newMapCache()
 
+	// async job starts are not cached by default
+	cacheAll := func(*http.Request, *http.Response) bool { return true }
 	cl, err := NewClientWithResponses(ServerURL,
 		WithHTTPClient(fake),
-		WithCache(cache),
+		WithCache(cache, WithCachePolicy(cacheAll)),
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -169,3 +173,158 @@ func TestWithCache_PostRequestCached(t *testing.T) {
 		t.Fatalf("expected still 1 call after cache hit, got %d", fake.calls)
 	}
 }
+
+func TestWithCache_DefaultPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		cached bool
+	}{
+		{"ok", "/v1/usr/08215600", http.StatusOK, true},
+		{"not found", "/v1/usr/08215600", http.StatusNotFound, true},
+		{"in progress", "/v1/usr/08215600", http.StatusAccepted, false},
+		{"too many requests", "/v1/usr/08215600", http.StatusTooManyRequests, false},
+		{"server error", "/v1/usr/08215600", http.StatusBadGateway, false},
+		{"async start", "/v1/individualsPdfReports", http.StatusOK, false},
+		{"async result", "/v1/individualsPdfReports/abc", http.StatusOK, true},
+		{"rate limits", "/v1/rateLimits", http.StatusOK, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := newScriptedDoer(map[string][]scriptedResponse{
+				tt.path: {{status: tt.status, body: `{}`}},
+			})
+			cache := newMapCache()
+			cl, err := NewClient(ServerURL, WithHTTPClient(doer), WithCache(cache))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ServerURL+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := cl.Client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if got := len(cache.store) == 1; got != tt.cached {
+				t.Fatalf("cached = %t, want %t", got, tt.cached)
+			}
+		})
+	}
+}
+
+// failingCache is a ContextCache whose backend is down.
+type failingCache struct{}
+
+func (failingCache) Get(context.Context, string, string) (CachedResponse, bool, error) {
+	return CachedResponse{}, false, errors.New("connection refused")
+}
+
+func (failingCache) Set(context.Context, string, string, CachedResponse) error {
+	return errors.New("connection refused")
+}
+
+func (failingCache) Delete(context.Context, string, string) error {
+	return errors.New("connection refused")
+}
+
+func TestWithContextCache_ErrorsAreMisses(t *testing.T) {
+	fake := &fakeDoer{}
+	var cacheErrs []error
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(fake),
+		WithContextCache(failingCache{}, WithCacheErrorHook(func(_ context.Context, err error) {
+			cacheErrs = append(cacheErrs, err)
+		})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusOK || fake.calls != 1 {
+		t.Fatalf("expected the request to reach the API, got status %d and %d calls", res.StatusCode(), fake.calls)
+	}
+	if len(cacheErrs) != 2 {
+		t.Fatalf("expected a get and a set error, got %v", cacheErrs)
+	}
+}
+
+func TestAdaptCache_Delete(t *testing.T) {
+	ctx := t.Context()
+
+	if err := AdaptCache(newMapCache()).Delete(ctx, "url", "key"); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+
+	cache := AdaptCache(NewMemoryCache(1 << 20))
+	url := ServerURL + "/v1/usr/08215600"
+	if err := cache.Set(ctx, url, "key", CachedResponse{StatusCode: http.StatusOK}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Delete(ctx, url, "key"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := cache.Get(ctx, url, "key"); ok {
+		t.Fatal("expected the entry to be deleted")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	fingerprint := func(method, url, auth string) string {
+		t.Helper()
+		req, err := http.NewRequest(method, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		key, err := Fingerprint(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+
+	base := fingerprint("GET", "https://api.youscore.com.ua/v1/usr/08215600?showCurrentData=true&a=1", "")
+	equivalent := []struct {
+		name   string
+		method string
+		url    string
+		auth   string
+	}{
+		{"query order", "GET", "https://api.youscore.com.ua/v1/usr/08215600?a=1&showCurrentData=true", ""},
+		{"query api key", "GET", "https://api.youscore.com.ua/v1/usr/08215600?showCurrentData=true&apiKey=secret&a=1", ""},
+		{"auth header", "GET", "https://api.youscore.com.ua/v1/usr/08215600?showCurrentData=true&a=1", "bearer secret"},
+		{"path case", "get", "https://API.youscore.com.ua/v1/USR/08215600?showCurrentData=true&a=1", ""},
+	}
+	for _, tt := range equivalent {
+		if got := fingerprint(tt.method, tt.url, tt.auth); got != base {
+			t.Errorf("%s: expected the same fingerprint", tt.name)
+		}
+	}
+
+	if got := fingerprint("GET", "https://api.youscore.com.ua/v1/usr/08215600?showCurrentData=false&a=1", ""); got == base {
+		t.Error("expected a different fingerprint for different query values")
+	}
+	if got := fingerprint("POST", "https://api.youscore.com.ua/v1/usr/08215600?showCurrentData=true&a=1", ""); got == base {
+		t.Error("expected a different fingerprint for a different method")
+	}
+
+	upper := fingerprint("GET", "https://api.youscore.com.ua/v1/corruptedPersons/ABC", "")
+	if got := fingerprint("GET", "https://api.youscore.com.ua/v1/CORRUPTEDPERSONS/ABC", ""); got != upper {
+		t.Error("expected the same fingerprint for a different case of a literal path segment")
+	}
+	if got := fingerprint("GET", "https://api.youscore.com.ua/v1/corruptedPersons/abc", ""); got == upper {
+		t.Error("expected a different fingerprint for a different case of a path parameter")
+	}
+}
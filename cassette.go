@@ -0,0 +1,191 @@
+package youscore
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// ErrCassetteMiss is returned by a Cassette in CassetteReplay mode for a request it has no recording of.
+var ErrCassetteMiss = errors.New("youscore: no recorded interaction")
+
+// CassetteMode controls whether a Cassette calls the API.
+type CassetteMode int
+
+const (
+	// CassetteReplay only serves recorded interactions, unmatched requests fail with ErrCassetteMiss.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord calls the API for every request and records all interactions anew.
+	CassetteRecord
+	// CassetteRecordMissing serves recorded interactions and records the missing ones.
+	CassetteRecordMissing
+)
+
+// Interaction is a recorded request and its response.
+// Auth headers are never recorded and auth query parameters are removed from the URL.
+type Interaction struct {
+	// Fingerprint is the Fingerprint of the request, used to match requests on replay.
+	Fingerprint string      `json:"fingerprint"`
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	RequestBody []byte      `json:"requestBody,omitempty"`
+	StatusCode  int         `json:"statusCode"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body"`
+}
+
+// Cassette is an HttpRequestDoer that records interactions with the API to a file,
+// and replays them in tests without network access:
+//
+//	cassette, err := youscore.NewCassette("testdata/usr.json", youscore.CassetteReplay, nil)
+//	cl, err := youscore.NewClientWithResponses(youscore.ServerURL,
+//		youscore.WithHTTPClient(cassette),
+//		youscore.WithAPIKeys(keys),
+//	)
+//	// ...
+//	err = cassette.Save() // after recording
+//
+// Identical requests (e.g. polling an async result) are replayed in the recorded order,
+// the last response is repeated once they run out.
+type Cassette struct {
+	path  string
+	mode  CassetteMode
+	inner HttpRequestDoer
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     map[string]int // by fingerprint
+	changed      bool
+}
+
+// NewCassette loads the cassette at path. In CassetteReplay mode the file must exist,
+// in the record modes it is created by Save. inner is the HttpRequestDoer used to
+// record, http.DefaultClient if nil.
+func NewCassette(path string, mode CassetteMode, inner HttpRequestDoer) (*Cassette, error) {
+	if inner == nil {
+		inner = http.DefaultClient
+	}
+	c := &Cassette{
+		path:     path,
+		mode:     mode,
+		inner:    inner,
+		replayed: make(map[string]int),
+	}
+	if mode == CassetteRecord {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && mode == CassetteRecordMissing {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read cassette: %w", err)
+	}
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, fmt.Errorf("unmarshal cassette: %w", err)
+	}
+	return c, nil
+}
+
+// Do implements HttpRequestDoer.
+func (c *Cassette) Do(req *http.Request) (*http.Response, error) {
+	fingerprint, reqBody, err := cacheKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.mode != CassetteRecord {
+		if in, ok := c.replay(fingerprint); ok {
+			return &http.Response{
+				StatusCode: in.StatusCode,
+				Header:     in.Header.Clone(),
+				Body:       io.NopCloser(bytes.NewReader(in.Body)),
+				Request:    req,
+			}, nil
+		}
+		if c.mode == CassetteReplay {
+			return nil, fmt.Errorf("%w for %s %s in %s", ErrCassetteMiss, req.Method, sanitizeURL(req.URL.String()), c.path)
+		}
+	}
+
+	resp, err := c.inner.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	header := resp.Header.Clone()
+	for _, h := range []string{"Authorization", "Set-Cookie"} {
+		header.Del(h)
+	}
+	c.mu.Lock()
+	c.interactions = append(c.interactions, Interaction{
+		Fingerprint: fingerprint,
+		Method:      req.Method,
+		URL:         sanitizeURL(req.URL.String()),
+		RequestBody: reqBody,
+		StatusCode:  resp.StatusCode,
+		Header:      header,
+		Body:        body,
+	})
+	c.changed = true
+	c.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// replay returns the next recorded interaction of a fingerprint.
+func (c *Cassette) replay(fingerprint string) (Interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var matches []Interaction
+	for _, in := range c.interactions {
+		if in.Fingerprint == fingerprint {
+			matches = append(matches, in)
+		}
+	}
+	if len(matches) == 0 {
+		return Interaction{}, false
+	}
+	i := min(c.replayed[fingerprint], len(matches)-1)
+	c.replayed[fingerprint]++
+	return matches[i], true
+}
+
+// Interactions returns the recorded interactions.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// Save writes the cassette to its file, if anything was recorded.
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.changed {
+		return nil
+	}
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal cassette: %w", err)
+	}
+	if err := writeFileAtomic(c.path, data); err != nil {
+		return fmt.Errorf("write cassette: %w", err)
+	}
+	c.changed = false
+	return nil
+}
@@ -0,0 +1,95 @@
+package youscore
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {
+			{status: http.StatusAccepted},
+			{status: http.StatusOK, body: `{"code":"08215600"}`},
+		},
+		"/v1/usr/11111111": {{status: http.StatusOK, body: `{"code":"11111111"}`}},
+	})
+	keys := WithAPIKeys(APIKeys{DataAnalytics: "secret-key"})
+	ctx := t.Context()
+
+	// record
+	rec, err := NewCassette(path, CassetteRecord, doer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(rec), keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if _, err := cl.GetV1UsrContractorCodeWithResponse(ctx, "08215600", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "secret-key") {
+		t.Fatal("expected the API key to be scrubbed")
+	}
+
+	// replay in order, without calling the API
+	replay, err := NewCassette(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl, err = NewClientWithResponses(ServerURL, WithHTTPClient(replay), keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []int{http.StatusAccepted, http.StatusOK, http.StatusOK} {
+		res, err := cl.GetV1UsrContractorCodeWithResponse(ctx, "08215600", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.StatusCode() != want {
+			t.Fatalf("expected %d, got %d", want, res.StatusCode())
+		}
+	}
+	_, err = cl.GetV1UsrContractorCodeWithResponse(ctx, "11111111", nil)
+	if !errors.Is(err, ErrCassetteMiss) {
+		t.Fatalf("expected ErrCassetteMiss, got %v", err)
+	}
+
+	// record only the missing interaction
+	missing, err := NewCassette(path, CassetteRecordMissing, doer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl, err = NewClientWithResponses(ServerURL, WithHTTPClient(missing), keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, code := range []string{"08215600", "11111111"} {
+		if _, err := cl.GetV1UsrContractorCodeWithResponse(ctx, code, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := doer.count("/v1/usr/08215600"); got != 2 {
+		t.Fatalf("expected the recorded request not to be re-recorded, got %d calls", got)
+	}
+	if got := doer.count("/v1/usr/11111111"); got != 1 {
+		t.Fatalf("expected the missing request to be recorded, got %d calls", got)
+	}
+	if got := len(missing.Interactions()); got != 3 {
+		t.Fatalf("expected 3 interactions, got %d", got)
+	}
+}
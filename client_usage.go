@@ -3,7 +3,7 @@ package youscore
 import (
 	"context"
 	"net/http"
-	"strings"
+	"time"
 )
 
 type APIType string
@@ -14,57 +14,125 @@ const (
 	APITypeData     APIType = "data"
 )
 
+// WithUsageTracking returns a ClientOption that calls usageFn before every request is sent.
+// When WithInProgressRetry re-issues a request, usageFn is called again for each attempt,
+// RequestAttempt(ctx) returns the attempt number.
 func WithUsageTracking(usageFn func(ctx context.Context, apiType APIType, path string)) ClientOption {
 	return func(c *Client) error {
 		c.RequestEditors = append(c.RequestEditors, func(ctx context.Context, req *http.Request) error {
-			path := req.URL.Path
-			path, _, _ = strings.Cut(path, "?")
-
-			apiType := aPITypeForPath(path)
-			usageFn(ctx, apiType, path)
-
+			usageFn(ctx, aPITypeForRequest(req), req.URL.Path)
 			return nil
 		})
 		return nil
 	}
 }
 
-// aPITypeForPath returns the appropriate API type for the given request path.
-// This can be used to calculate pricing and implement cost tracking
-func aPITypeForPath(path string) APIType {
-	path = strings.TrimPrefix(path, "/")
+// aPITypeForRequest returns the information type of the operation being called.
+// This can be used to calculate pricing and implement cost tracking.
+// Requests that do not match any operation are reported as DATA.
+func aPITypeForRequest(req *http.Request) APIType {
+	op, ok := LookupOperation(req.Method, req.URL.EscapedPath())
+	if !ok {
+		return APITypeData
+	}
+	return op.InfoType
+}
+
+// Usage describes a single call made through the client, as reported by WithUsageHook.
+type Usage struct {
+	// Operation is the name of the operation, e.g. GetV1UsrContractorCode. Empty if the request matched no operation.
+	Operation string
+	// APIType is the information type of the operation.
+	APIType APIType
+	// Billable reports whether the call reached the API for an operation that consumes a transaction,
+	// and the API answered with a 2xx status. Cache hits, coalesced and failed calls are never billable.
+	Billable bool
+	// FromCache reports whether the response was served by WithCache without calling the API.
+	FromCache bool
+	// Coalesced reports whether the response was shared from an identical in-flight call, see WithRequestCoalescing.
+	Coalesced bool
+	// StatusCode is the final status code, or 0 if the request failed.
+	StatusCode int
+	// Attempts is the number of requests sent to the API, more than 1 when WithInProgressRetry
+	// or WithRetry re-issued the request.
+	Attempts int
+	// Latency is the total time spent on the call, including retries.
+	Latency time.Duration
+	// Err is the transport error, if any.
+	Err error
+}
+
+// WithUsageHook returns a ClientOption that calls usageFn after every call completes.
+// Unlike WithUsageTracking it knows the outcome of the call, so it can tell billable calls
+// from cache hits, polling of async results and failures. This allows reconciling internal
+// counts against the RequestsCount breakdown of CheckRateLimits.
+//
+// Apply this option after WithCache and WithInProgressRetry, so that it wraps them.
+func WithUsageHook(usageFn func(ctx context.Context, usage Usage)) ClientOption {
+	return func(c *Client) error {
+		inner := c.Client
+		if inner == nil {
+			inner = &http.Client{}
+		}
+		c.Client = &usageDoer{
+			inner:   inner,
+			usageFn: usageFn,
+		}
+		return nil
+	}
+}
+
+type usageDoer struct {
+	inner   HttpRequestDoer
+	usageFn func(ctx context.Context, usage Usage)
+}
+
+func (d *usageDoer) Do(req *http.Request) (*http.Response, error) {
+	rec := &callRecord{}
+	ctx := context.WithValue(req.Context(), callRecordCtxKey{}, rec)
+	req = req.WithContext(ctx)
 
-	switch {
-	// Custom (PDF reports, affiliates, auctions)
-	case strings.HasPrefix(path, "v1/contractors/pdf-file/"),
-		strings.HasPrefix(path, "v1/contractorsPdf/"),
-		strings.HasPrefix(path, "v1/individuals/pdf-reports"),
-		strings.HasPrefix(path, "v1/individualsPdfReports"),
-		strings.HasPrefix(path, "v1/affiliates"),
-		strings.HasPrefix(path, "v1/setam/"):
-		return APITypeCustom
+	start := time.Now()
+	resp, err := d.inner.Do(req)
 
-	// Analytics
-	case strings.HasPrefix(path, "v1/history/"),
-		strings.HasPrefix(path, "v1/usrAdministrativeServicesResults/"),
-		strings.HasPrefix(path, "v1/usrDocuments/"),
-		strings.HasPrefix(path, "v1/expressAnalysis/"),
-		strings.HasPrefix(path, "v1/marketScoring/"),
-		strings.HasPrefix(path, "v1/financialScoring/"),
-		strings.HasPrefix(path, "v1/investigationsLegal"),
-		strings.HasPrefix(path, "v1/investigationsNatural"),
-		strings.HasPrefix(path, "v1/fig"),
-		strings.HasPrefix(path, "v1/individualsFigCompanies"),
-		strings.HasPrefix(path, "v1/courtCaseGroup/"),
-		strings.HasPrefix(path, "v1/encumbrances/details/"),
-		strings.HasPrefix(path, "v1/encumbrances/resultdetails/"),
-		strings.HasPrefix(path, "v1/realEstate/details/"),
-		strings.HasPrefix(path, "v1/realEstate/resultdetails/"),
-		strings.HasPrefix(path, "v1/tenders/risks/"),
-		strings.HasPrefix(path, "v1/sanctions"):
-		return APITypeAnalysis
+	attempts := 1 + rec.retries
+	if rec.fromCache || rec.coalesced {
+		attempts = 0
+	}
+	usage := Usage{
+		APIType:   APITypeData,
+		FromCache: rec.fromCache,
+		Coalesced: rec.coalesced,
+		Attempts:  attempts,
+		Latency:   time.Since(start),
+		Err:       err,
+	}
+	if resp != nil {
+		usage.StatusCode = resp.StatusCode
+	}
+	if op, ok := LookupOperation(req.Method, req.URL.EscapedPath()); ok {
+		usage.Operation = op.Name
+		usage.APIType = op.InfoType
+		usage.Billable = op.Transaction && attempts > 0 && err == nil &&
+			usage.StatusCode >= 200 && usage.StatusCode < 300
 	}
+	d.usageFn(ctx, usage)
+
+	return resp, err
+}
+
+// callRecord collects what happened to a call on its way through the doer chain.
+// It is placed in the request context by usageDoer and filled in by the inner doers.
+type callRecord struct {
+	fromCache bool
+	coalesced bool
+	retries   int // requests re-issued by WithRetry and WithInProgressRetry
+}
+
+type callRecordCtxKey struct{}
 
-	// Default: DATA (covers all remaining endpoints)
-	return APITypeData
+// callRecordFromContext returns the callRecord of the call, or nil if usage is not tracked.
+func callRecordFromContext(ctx context.Context) *callRecord {
+	rec, _ := ctx.Value(callRecordCtxKey{}).(*callRecord)
+	return rec
 }
@@ -0,0 +1,65 @@
+package youscore
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithUsageHook(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {
+			{status: http.StatusAccepted},
+			{status: http.StatusOK, body: `{"code":"08215600"}`},
+		},
+		"/v1/corruptedPersons/abc": {{status: http.StatusOK, body: `{}`}},
+	})
+
+	var usages []Usage
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithInProgressRetry(map[string]InProgressPolicy{
+			"/v1/usr/": {MaxAttempts: 3, Delay: time.Millisecond},
+		}),
+		WithCache(newMapCache()),
+		WithUsageHook(func(_ context.Context, u Usage) {
+			usages = append(usages, u)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := t.Context()
+	for range 2 {
+		if _, err := cl.GetV1UsrContractorCodeWithResponse(ctx, "08215600", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := cl.GetV1CorruptedPersonsResultIdWithResponse(ctx, "abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(usages) != 3 {
+		t.Fatalf("expected 3 usages, got %d", len(usages))
+	}
+
+	first := usages[0]
+	if first.Operation != "GetV1UsrContractorCode" || first.APIType != APITypeData {
+		t.Errorf("unexpected operation: %+v", first)
+	}
+	if !first.Billable || first.FromCache || first.StatusCode != http.StatusOK || first.Attempts != 2 {
+		t.Errorf("first call: %+v", first)
+	}
+
+	cached := usages[1]
+	if cached.Billable || !cached.FromCache || cached.Attempts != 0 {
+		t.Errorf("cached call: %+v", cached)
+	}
+
+	result := usages[2]
+	if result.Operation != "GetV1CorruptedPersonsResultId" || result.Billable {
+		t.Errorf("result poll should not be billable: %+v", result)
+	}
+}
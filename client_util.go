@@ -3,7 +3,6 @@ package youscore
 import (
 	"context"
 	"net/http"
-	"strings"
 )
 
 const ServerURL = "https://api.youscore.com.ua"
@@ -12,14 +11,28 @@ const ServerURL = "https://api.youscore.com.ua"
 type APIKeys struct {
 	// DataAnalytics is used for data and analytics endpoints (default for most endpoints).
 	DataAnalytics string
-	// PDFLegalEntities is used for the legal entity PDF report endpoint (/v1/contractors/pdf-file/).
+	// PDFLegalEntities is used for the legal entity PDF report endpoint (/v1/contractorsPdf/file/).
 	PDFLegalEntities string
-	// PDFIndividuals is used for the individual PDF report endpoints (/v1/individuals/pdf-reports).
+	// PDFIndividuals is used for the individual PDF report endpoints (/v1/individualsPdfReports).
 	PDFIndividuals string
 	// Affiliates is used for the affiliates endpoints (/v1/affiliates).
 	Affiliates string
 }
 
+// ForCategory returns the key for the given category.
+func (k APIKeys) ForCategory(category APIKeyCategory) string {
+	switch category {
+	case KeyPDFLegalEntities:
+		return k.PDFLegalEntities
+	case KeyPDFIndividuals:
+		return k.PDFIndividuals
+	case KeyAffiliates:
+		return k.Affiliates
+	default:
+		return k.DataAnalytics
+	}
+}
+
 // WithBearerAuth returns a ClientOption that sets the Authorization header
 // with the given API key on every request.
 func WithBearerAuth(apiKey string) ClientOption {
@@ -30,31 +43,27 @@ func WithBearerAuth(apiKey string) ClientOption {
 }
 
 // WithAPIKeys returns a ClientOption that selects the correct API key based on
-// the request path. The four key categories are:
+// the operation being called. The four key categories are:
 //   - DataAnalytics: used for all data and analytics endpoints (default)
-//   - PDFLegalEntities: used for /v1/contractors/pdf-file/ endpoints
-//   - PDFIndividuals: used for /v1/individuals/pdf-reports endpoints
+//   - PDFLegalEntities: used for /v1/contractorsPdf/file/ endpoints
+//   - PDFIndividuals: used for /v1/individualsPdfReports endpoints
 //   - Affiliates: used for /v1/affiliates endpoints
+//
+// The category of each operation is generated from the spec, see operations.gen.go.
 func WithAPIKeys(keys APIKeys) ClientOption {
 	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
-		key := apiKeyForPath(keys, req.URL.Path)
+		key := apiKeyForPath(keys, req.Method, req.URL.EscapedPath())
 		req.Header.Set("Authorization", "bearer "+key)
 		return nil
 	})
 }
 
-// apiKeyForPath returns the appropriate API key for the given request path.
-func apiKeyForPath(keys APIKeys, path string) string {
-	path = strings.TrimPrefix(path, "/")
-
-	switch {
-	case strings.HasPrefix(path, "v1/contractors/pdf-file/"):
-		return keys.PDFLegalEntities
-	case strings.HasPrefix(path, "v1/individuals/pdf-reports"):
-		return keys.PDFIndividuals
-	case strings.HasPrefix(path, "v1/affiliates"):
-		return keys.Affiliates
-	default:
+// apiKeyForPath returns the appropriate API key for the given request method and path.
+// Paths that do not match any operation use the DataAnalytics key.
+func apiKeyForPath(keys APIKeys, method, path string) string {
+	op, ok := LookupOperation(method, path)
+	if !ok {
 		return keys.DataAnalytics
 	}
+	return keys.ForCategory(op.Key)
 }
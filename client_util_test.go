@@ -1,6 +1,10 @@
 package youscore
 
-import "testing"
+import (
+	"context"
+	"net/http"
+	"testing"
+)
 
 func TestApiKeyForPath(t *testing.T) {
 	keys := APIKeys{
@@ -11,30 +15,70 @@ func TestApiKeyForPath(t *testing.T) {
 	}
 
 	tests := []struct {
-		path string
-		want string
+		method string
+		path   string
+		want   string
 	}{
-		{"/v1/usr/00032112", "da-key"},
-		{"/v1/sanctions", "da-key"},
-		{"/v1/rate-limits", "da-key"},
-		{"/v1/court/00032112", "da-key"},
-		{"/v1/contractors/pdf-file/00032112", "pdf-legal-key"},
-		{"/v1/contractors/pdf-file/12345678", "pdf-legal-key"},
-		{"/v1/individuals/pdf-reports", "pdf-ind-key"},
-		{"/v1/individuals/pdf-reports/some-result-id", "pdf-ind-key"},
-		{"/v1/affiliates/query", "aff-key"},
-		{"/v1/affiliates/some-id", "aff-key"},
-		// Other /v1/individuals/ endpoints use data+analytics
-		{"/v1/individuals/full-name-info", "da-key"},
-		{"/v1/individuals/related-persons", "da-key"},
+		{"GET", "/v1/usr/00032112", "da-key"},
+		{"GET", "/v1/sanctions", "da-key"},
+		{"GET", "/v1/rateLimits", "da-key"},
+		{"GET", "/v1/court/00032112", "da-key"},
+		{"GET", "/v1/contractorsPdf/file/00032112", "pdf-legal-key"},
+		{"GET", "/v1/contractorsPdf/file/12345678", "pdf-legal-key"},
+		{"GET", "/v1/individualsPdfReports", "pdf-ind-key"},
+		{"GET", "/v1/individualsPdfReports/some-result-id", "pdf-ind-key"},
+		{"POST", "/v1/affiliates/query", "aff-key"},
+		{"GET", "/v1/affiliates/result/some-id", "aff-key"},
+		// Other individuals endpoints use data+analytics
+		{"GET", "/v1/individualsFullNameInfo", "da-key"},
+		{"GET", "/v1/individualsRelatedPersons", "da-key"},
+		// Unknown paths fall back to data+analytics
+		{"GET", "/v1/unknown", "da-key"},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.path, func(t *testing.T) {
-			got := apiKeyForPath(keys, tt.path)
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			got := apiKeyForPath(keys, tt.method, tt.path)
 			if got != tt.want {
-				t.Errorf("apiKeyForPath(%q) = %q, want %q", tt.path, got, tt.want)
+				t.Errorf("apiKeyForPath(%q, %q) = %q, want %q", tt.method, tt.path, got, tt.want)
 			}
 		})
 	}
 }
+
+func TestWithAPIKeys_GeneratedClient(t *testing.T) {
+	keys := APIKeys{
+		DataAnalytics:    "da-key",
+		PDFLegalEntities: "pdf-legal-key",
+		PDFIndividuals:   "pdf-ind-key",
+		Affiliates:       "aff-key",
+	}
+
+	var got string
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(&fakeDoer{}),
+		WithAPIKeys(keys),
+		WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			got = req.Header.Get("Authorization")
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := t.Context()
+	if _, err := cl.GetV1ContractorsPdfFileContractorCode(ctx, "08215600", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got != "bearer pdf-legal-key" {
+		t.Errorf("contractors pdf: got %q", got)
+	}
+
+	if _, err := cl.GetV1IndividualsPdfReports(ctx, &GetV1IndividualsPdfReportsParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if got != "bearer pdf-ind-key" {
+		t.Errorf("individuals pdf: got %q", got)
+	}
+}
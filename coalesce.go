@@ -0,0 +1,130 @@
+package youscore
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// WithRequestCoalescing returns a ClientOption that deduplicates identical concurrent requests:
+// while a request is in flight, identical requests (same Fingerprint) wait for its response
+// instead of calling the API, so only one transaction is consumed.
+// Every waiter gets its own copy of the response body.
+//
+// Only requests with the same CacheMode (see WithCacheMode) are coalesced.
+//
+// Waiters share the outcome of the first request, including its error if it fails.
+// If the first request only failed because its own context was cancelled, a waiter
+// makes the request again instead.
+//
+// Options wrap the client configured by the options before them: apply this option before
+// WithCache, so that cache hits are answered before reaching it, and before WithUsageHook.
+func WithRequestCoalescing() ClientOption {
+	return func(c *Client) error {
+		inner := c.Client
+		if inner == nil {
+			inner = &http.Client{}
+		}
+		c.Client = &coalescingDoer{
+			inner:    inner,
+			inFlight: make(map[coalesceKey]*inFlightCall),
+		}
+		return nil
+	}
+}
+
+type coalescingDoer struct {
+	inner HttpRequestDoer
+
+	mu       sync.Mutex
+	inFlight map[coalesceKey]*inFlightCall
+}
+
+// coalesceKey identifies identical requests: a request made with NoCache or RefreshCache
+// must not be answered by a request that was made with a different mode.
+type coalesceKey struct {
+	fingerprint string
+	mode        CacheMode
+}
+
+// inFlightCall is the shared outcome of a coalesced request, available once done is closed.
+type inFlightCall struct {
+	done   chan struct{}
+	status int
+	header http.Header // a copy owned by the call, waiters clone it
+	body   []byte
+	err    error
+	// abandoned is set if the request failed because the context of its caller is done,
+	// which says nothing about the request of a waiter.
+	abandoned bool
+}
+
+func (d *coalescingDoer) Do(req *http.Request) (*http.Response, error) {
+	fingerprint, _, err := cacheKey(req)
+	if err != nil {
+		return nil, err
+	}
+	key := coalesceKey{fingerprint: fingerprint, mode: cacheModeFromContext(req.Context())}
+
+	for {
+		d.mu.Lock()
+		call, ok := d.inFlight[key]
+		if !ok {
+			break // d.mu is held
+		}
+		d.mu.Unlock()
+
+		resp, err := d.wait(req, call)
+		if call.abandoned && req.Context().Err() == nil {
+			continue
+		}
+		return resp, err
+	}
+	call := &inFlightCall{done: make(chan struct{})}
+	d.inFlight[key] = call
+	d.mu.Unlock()
+
+	resp, err := d.inner.Do(req)
+	if err == nil {
+		call.body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		call.status = resp.StatusCode
+		call.header = resp.Header.Clone()
+	}
+	call.err = err
+	call.abandoned = err != nil && req.Context().Err() != nil
+
+	d.mu.Lock()
+	delete(d.inFlight, key)
+	d.mu.Unlock()
+	close(call.done)
+
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(call.body))
+	return resp, nil
+}
+
+// wait blocks until the in-flight call completes, or the context of req is done.
+func (d *coalescingDoer) wait(req *http.Request, call *inFlightCall) (*http.Response, error) {
+	select {
+	case <-call.done:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	if rec := callRecordFromContext(req.Context()); rec != nil && !call.abandoned {
+		rec.coalesced = true
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	return &http.Response{
+		StatusCode: call.status,
+		Header:     call.header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(call.body)),
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,183 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+)
+
+// blockingDoer answers every request once release is closed, or fails once its context is done.
+type blockingDoer struct {
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (d *blockingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.calls.Add(1)
+	select {
+	case <-d.release:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(`{"ok":true}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestWithRequestCoalescing(t *testing.T) {
+	synctest.Test(t, testWithRequestCoalescing)
+}
+
+func testWithRequestCoalescing(t *testing.T) {
+	doer := &blockingDoer{release: make(chan struct{})}
+	var billable atomic.Int32
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithRequestCoalescing(),
+		WithUsageHook(func(_ context.Context, u Usage) {
+			if u.Billable {
+				billable.Add(1)
+			}
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 5
+	var wg sync.WaitGroup
+	bodies := make([]*GetV1UsrContractorCodeResponse, n)
+	for i := range n {
+		wg.Go(func() {
+			res, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			bodies[i] = res
+		})
+	}
+
+	// wait until all identical calls joined the first one
+	synctest.Wait()
+	close(doer.release)
+	wg.Wait()
+
+	if got := doer.calls.Load(); got != 1 {
+		t.Fatalf("expected 1 upstream call, got %d", got)
+	}
+	if got := billable.Load(); got != 1 {
+		t.Fatalf("expected 1 billable call, got %d", got)
+	}
+	for i, res := range bodies {
+		if res == nil || string(res.Body) != `{"ok":true}` {
+			t.Fatalf("call %d: unexpected response %+v", i, res)
+		}
+	}
+}
+
+func TestWithRequestCoalescing_CacheMode(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		doer := &blockingDoer{release: make(chan struct{})}
+		cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer), WithRequestCoalescing())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var wg sync.WaitGroup
+		for _, mode := range []CacheMode{CacheDefault, NoCache, NoCache} {
+			wg.Go(func() {
+				ctx := WithCacheMode(t.Context(), mode)
+				if _, err := cl.GetV1UsrContractorCodeWithResponse(ctx, "08215600", nil); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+		synctest.Wait()
+		close(doer.release)
+		wg.Wait()
+
+		if got := doer.calls.Load(); got != 2 {
+			t.Fatalf("expected 1 upstream call per cache mode, got %d", got)
+		}
+	})
+}
+
+func TestWithRequestCoalescing_LeaderCancelled(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		doer := &blockingDoer{release: make(chan struct{})}
+		cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer), WithRequestCoalescing())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		leaderCtx, cancel := context.WithCancel(t.Context())
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			if _, err := cl.GetV1UsrContractorCodeWithResponse(leaderCtx, "08215600", nil); !errors.Is(err, context.Canceled) {
+				t.Errorf("expected the leader to be cancelled, got %v", err)
+			}
+		})
+		synctest.Wait()
+		wg.Go(func() {
+			res, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+			if err != nil || string(res.Body) != `{"ok":true}` {
+				t.Errorf("expected the waiter to make the request again, got %v", err)
+			}
+		})
+		synctest.Wait()
+
+		cancel()
+		synctest.Wait()
+		close(doer.release)
+		wg.Wait()
+
+		if got := doer.calls.Load(); got != 2 {
+			t.Fatalf("expected the waiter to call the API after the leader was cancelled, got %d calls", got)
+		}
+	})
+}
+
+func TestWithRequestCoalescing_WithCache(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		doer := &blockingDoer{release: make(chan struct{})}
+		cl, err := NewClientWithResponses(ServerURL,
+			WithHTTPClient(doer),
+			WithRequestCoalescing(),
+			WithCache(newMapCache()),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var wg sync.WaitGroup
+		for range 3 {
+			wg.Go(func() {
+				if _, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+		synctest.Wait()
+		close(doer.release)
+		wg.Wait()
+
+		// the misses were coalesced, the next request is a cache hit
+		res, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+		if err != nil || string(res.Body) != `{"ok":true}` {
+			t.Fatalf("unexpected cached response: %v", err)
+		}
+		if got := doer.calls.Load(); got != 1 {
+			t.Fatalf("expected 1 upstream call, got %d", got)
+		}
+	})
+}
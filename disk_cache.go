@@ -0,0 +1,326 @@
+package youscore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DiskCacheOption configures a DiskCache.
+type DiskCacheOption func(*DiskCache)
+
+// WithDiskCacheTTL sets how long entries stay valid. Defaults to 7 days.
+func WithDiskCacheTTL(ttl time.Duration) DiskCacheOption {
+	return func(c *DiskCache) {
+		c.ttl = ttl
+	}
+}
+
+// WithDiskCacheMaxBytes caps the total size of the cache files.
+// Once exceeded, the oldest entries are removed in the background, down to 90% of the cap.
+// Defaults to no cap.
+func WithDiskCacheMaxBytes(maxBytes int64) DiskCacheOption {
+	return func(c *DiskCache) {
+		c.maxBytes = maxBytes
+	}
+}
+
+// WithDiskCacheCompactionInterval sets how often Run compacts the cache. Defaults to 1 hour.
+func WithDiskCacheCompactionInterval(interval time.Duration) DiskCacheOption {
+	return func(c *DiskCache) {
+		c.interval = interval
+	}
+}
+
+// DiskCache is a persistent Cache that stores one file per key, in directories sharded by
+// the first two characters of the hashed key.
+//
+// Files are written to a temporary file, synced and renamed into place, so an interrupted process
+// or a crash never leaves a partially written entry behind. Expired entries are removed on access
+// and by Compact, which also enforces the size cap by removing the oldest entries first.
+//
+//	cache, err := youscore.NewDiskCache("cache", youscore.WithDiskCacheMaxBytes(1<<30))
+//	go cache.Run(ctx)
+type DiskCache struct {
+	dir      string
+	ttl      time.Duration
+	maxBytes int64
+	interval time.Duration
+	now      func() time.Time
+
+	mu         sync.Mutex // guards bytes and serialises compaction
+	bytes      int64
+	compacting atomic.Bool // set while Set compacts in the background
+}
+
+// diskCacheEntry is the file format of a DiskCache entry.
+type diskCacheEntry struct {
+	URL       string         `json:"url"`
+	ExpiresAt time.Time      `json:"expiresAt"`
+	Response  CachedResponse `json:"response"`
+}
+
+// tempPrefix marks files that are still being written.
+const tempPrefix = ".tmp-"
+
+// NewDiskCache creates a DiskCache in dir, creating the directory if needed.
+// Entries written by a previous process are reused.
+func NewDiskCache(dir string, opts ...DiskCacheOption) (*DiskCache, error) {
+	c := &DiskCache{
+		dir:      dir,
+		ttl:      7 * 24 * time.Hour,
+		interval: time.Hour,
+		now:      time.Now,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create cache dir: %w", err)
+	}
+	if err := c.Compact(); err != nil {
+		return nil, fmt.Errorf("compact: %w", err)
+	}
+	return c, nil
+}
+
+// Get implements Cache.
+func (c *DiskCache) Get(_ string, key string) (CachedResponse, bool) {
+	name := c.path(key)
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return CachedResponse{}, false
+	}
+
+	var entry diskCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || !c.now().Before(entry.ExpiresAt) {
+		c.remove(name, int64(len(data)))
+		return CachedResponse{}, false
+	}
+	return entry.Response, true
+}
+
+// Set implements Cache. Write errors are ignored, the response is simply not cached.
+func (c *DiskCache) Set(rawURL string, key string, resp CachedResponse) {
+	data, err := json.Marshal(diskCacheEntry{
+		URL:       rawURL,
+		ExpiresAt: c.now().Add(c.ttl),
+		Response:  resp,
+	})
+	if err != nil {
+		return
+	}
+
+	name := c.path(key)
+	var prev int64
+	if info, err := os.Stat(name); err == nil {
+		prev = info.Size()
+	}
+	if err := writeFileAtomic(name, data); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	c.bytes += int64(len(data)) - prev
+	over := c.maxBytes > 0 && c.bytes > c.maxBytes
+	c.mu.Unlock()
+
+	// compaction reads every entry, keep it off the request path; as it goes down to 90%
+	// of the cap, the next one only starts once the cache has grown again
+	if over && c.compacting.CompareAndSwap(false, true) {
+		go func() {
+			defer c.compacting.Store(false)
+			_ = c.Compact()
+		}()
+	}
+}
+
+// Delete removes the entry of the given key, if any.
+func (c *DiskCache) Delete(_ string, key string) {
+	name := c.path(key)
+	if info, err := os.Stat(name); err == nil {
+		c.remove(name, info.Size())
+	}
+}
+
+// Run compacts the cache periodically, until ctx is done.
+func (c *DiskCache) Run(ctx context.Context) error {
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			_ = c.Compact()
+		}
+	}
+}
+
+// Compact removes expired entries and leftover temporary files, and then removes the
+// oldest entries until the cache is below 90% of its size cap.
+// Only the shard directories and entry files of the cache are considered, other files in dir are kept.
+func (c *DiskCache) Compact() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	type file struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var (
+		files []file
+		total int64
+		now   = c.now()
+	)
+	shards, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		// only the cache's own layout is touched, other files in dir are left alone
+		if !shard.IsDir() || !isHex(shard.Name(), 2) {
+			continue
+		}
+		entries, err := os.ReadDir(filepath.Join(c.dir, shard.Name()))
+		if err != nil {
+			continue // removed concurrently
+		}
+		for _, e := range entries {
+			name := e.Name()
+			isTemp := strings.HasPrefix(name, tempPrefix)
+			if e.IsDir() || !isTemp && (!isHex(name, sha256.Size*2) || !strings.HasPrefix(name, shard.Name())) {
+				continue
+			}
+			p := filepath.Join(c.dir, shard.Name(), name)
+			info, err := e.Info()
+			if err != nil {
+				continue
+			}
+
+			// a temporary file older than a minute belongs to an interrupted write
+			if isTemp {
+				if now.Sub(info.ModTime()) > time.Minute {
+					os.Remove(p)
+				}
+				continue
+			}
+
+			data, err := os.ReadFile(p)
+			if err != nil {
+				continue
+			}
+			var entry diskCacheEntry
+			if err := json.Unmarshal(data, &entry); err != nil || !now.Before(entry.ExpiresAt) {
+				os.Remove(p)
+				continue
+			}
+
+			files = append(files, file{path: p, size: info.Size(), modTime: info.ModTime()})
+			total += info.Size()
+		}
+	}
+
+	if c.maxBytes > 0 && total > c.maxBytes {
+		slices.SortFunc(files, func(a, b file) int { return a.modTime.Compare(b.modTime) })
+		target := c.maxBytes / 10 * 9
+		for _, f := range files {
+			if total <= target {
+				break
+			}
+			if err := os.Remove(f.path); err == nil || os.IsNotExist(err) {
+				total -= f.size
+			}
+		}
+	}
+
+	c.bytes = total
+	return nil
+}
+
+func (c *DiskCache) remove(name string, size int64) {
+	if err := os.Remove(name); err != nil {
+		return
+	}
+	c.mu.Lock()
+	c.bytes -= size
+	c.mu.Unlock()
+}
+
+// isHex reports whether s consists of n lowercase hex digits.
+func isHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, r := range s {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// path returns the file of a key. The key is hashed, so that any key is a safe file name.
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(c.dir, name[:2], name)
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and renames it into place.
+func writeFileAtomic(name string, data []byte) error {
+	dir := filepath.Dir(name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, tempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp) // no-op after a successful rename
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes a directory, so that a rename into it survives a crash.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil // directories cannot be synced, renames are durable once they return
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		d.Close()
+		return err
+	}
+	return d.Close()
+}
@@ -0,0 +1,149 @@
+package youscore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiskCache_Persistence(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	c, err := NewDiskCache(dir, WithDiskCacheTTL(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.now = func() time.Time { return now }
+	c.Set("url", "key", CachedResponse{StatusCode: 200, Body: []byte(`{"ok":true}`)})
+
+	// a new instance (e.g. after a restart) sees the entry
+	c, err = NewDiskCache(dir, WithDiskCacheTTL(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.now = func() time.Time { return now }
+	resp, ok := c.Get("url", "key")
+	if !ok || resp.StatusCode != 200 || string(resp.Body) != `{"ok":true}` {
+		t.Fatalf("unexpected entry: %+v, %v", resp, ok)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, ok := c.Get("url", "key"); ok {
+		t.Fatal("expected entry to expire")
+	}
+	if _, err := os.Stat(c.path("key")); !os.IsNotExist(err) {
+		t.Fatalf("expected expired file to be removed, got %v", err)
+	}
+}
+
+func TestDiskCache_Compact(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// leftover of an interrupted write
+	stale := filepath.Join(dir, "ab", tempPrefix+"123")
+	if err := os.MkdirAll(filepath.Dir(stale), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(stale, []byte("partial"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(stale, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	body := []byte(strings.Repeat("x", 200))
+	for i, key := range []string{"a", "b", "c", "d", "e"} {
+		c.Set("url", key, CachedResponse{StatusCode: 200, Body: body})
+		mod := old.Add(time.Duration(i) * time.Minute)
+		if err := os.Chtimes(c.path(key), mod, mod); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.maxBytes = 1000 // set after writing, so that Set does not compact in the background
+	if err := c.Compact(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("expected stale temporary file to be removed, got %v", err)
+	}
+	if _, ok := c.Get("url", "a"); ok {
+		t.Error("expected the oldest entry to be removed")
+	}
+	if _, ok := c.Get("url", "e"); !ok {
+		t.Error("expected the newest entry to be kept")
+	}
+	if c.bytes > 900 {
+		t.Errorf("expected the cache to be compacted below 90%% of the cap, got %d bytes", c.bytes)
+	}
+}
+
+func TestDiskCache_CompactsInBackground(t *testing.T) {
+	c, err := NewDiskCache(t.TempDir(), WithDiskCacheMaxBytes(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := []byte(strings.Repeat("x", 200))
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		c.Set("url", key, CachedResponse{StatusCode: 200, Body: body})
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		c.mu.Lock()
+		size := c.bytes
+		c.mu.Unlock()
+		if size <= 900 && !c.compacting.Load() {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the cache to be compacted below 90%% of the cap, got %d bytes", size)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDiskCache_KeepsForeignFiles(t *testing.T) {
+	dir := t.TempDir()
+	foreign := []string{
+		filepath.Join(dir, "notes.txt"),
+		filepath.Join(dir, "ab", "readme.md"),
+		filepath.Join(dir, "ab", strings.Repeat("0", 64)), // entry name of another shard
+		filepath.Join(dir, "docs", strings.Repeat("a", 64)),
+		filepath.Join(dir, "ab", "nested", tempPrefix+"123"),
+	}
+	old := time.Now().Add(-time.Hour)
+	for _, name := range foreign {
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte("not a cache entry"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(name, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c, err := NewDiskCache(dir, WithDiskCacheMaxBytes(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Compact(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range foreign {
+		if _, err := os.Stat(name); err != nil {
+			t.Errorf("expected %s to survive: %v", name, err)
+		}
+	}
+}
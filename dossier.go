@@ -0,0 +1,312 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DossierSection selects a part of a Dossier.
+type DossierSection string
+
+const (
+	SectionUSR                    DossierSection = "usr"
+	SectionOwnership              DossierSection = "ownership"
+	SectionShareholders           DossierSection = "shareholders"
+	SectionHistory                DossierSection = "history"
+	SectionStatut                 DossierSection = "statut"
+	SectionAdministrativeServices DossierSection = "administrativeServices"
+	SectionExpressAnalysis        DossierSection = "expressAnalysis"
+	SectionFinmon                 DossierSection = "finmon"
+	// The async sections, started and polled by the Poller.
+	SectionAffiliates   DossierSection = "affiliates"
+	SectionEncumbrances DossierSection = "encumbrances"
+	SectionRealEstate   DossierSection = "realEstate"
+)
+
+// DefaultDossierSections are fetched by Poller.Dossier if no sections are given.
+var DefaultDossierSections = []DossierSection{
+	SectionUSR,
+	SectionOwnership,
+	SectionShareholders,
+	SectionHistory,
+	SectionStatut,
+	SectionAdministrativeServices,
+	SectionExpressAnalysis,
+	SectionFinmon,
+}
+
+// SectionStatus is the outcome of fetching a DossierSection.
+type SectionStatus string
+
+const (
+	SectionOK         SectionStatus = "ok"
+	SectionNotFound   SectionStatus = "notFound"
+	SectionInProgress SectionStatus = "inProgress" // still in progress when the Poller timed out
+	SectionFailed     SectionStatus = "failed"
+)
+
+// SectionResult describes how a DossierSection was fetched.
+type SectionResult struct {
+	Status SectionStatus
+	// Err is the error of a section that is not SectionOK.
+	Err error
+	// ActualDate is the date of the data: the actualDate of the payload, or the asOfDate
+	// of a document (Ownership, Statut). It is zero if the payload has none.
+	ActualDate time.Time
+}
+
+// Dossier collects the data of a single legal entity. Sections that were not requested
+// or could not be fetched are nil, see Sections for why.
+type Dossier struct {
+	ContractorCode string
+	// Sections holds the outcome of every requested section.
+	Sections map[DossierSection]SectionResult
+
+	USR                    *YCApiModelsResponseUsrLegalPersonRegisterData
+	Ownership              *YCApiModelsResponseUsrUsrDocumentsUsrDocumentResultModel
+	Shareholders           *[]YCApiModelsResponseShareholders
+	History                *YCApiModelsResponseHistoryContractorHistory
+	Statut                 *YCApiModelsResponseUsrUsrDocumentsUsrDocumentResultModel
+	AdministrativeServices *YCApiModelsResponseUsrAdministrativeServicesAdministrativeServicesResultsModel
+	ExpressAnalysis        *YCApiModelsResponseExpressAnalysis
+	Finmon                 *YCApiModelsResponseExpressAnalysisFinMonFactors
+	Affiliates             *[]YCApiModelsAffiliatesAffiliateRoot
+	Encumbrances           *[]YCApiModelsResponseEncumbrancesMovableEncumbranceDetailsApiResponse
+	RealEstate             *[]YCApiModelsResponseRealEstateRealEstateDescriptor
+}
+
+// Err returns the errors of all sections that are not SectionOK, or nil.
+func (d *Dossier) Err() error {
+	var errs []error
+	for section, res := range d.Sections {
+		if res.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", section, res.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Dossier fetches the given sections (DefaultDossierSections if none) of a legal entity concurrently.
+// Sections that answer 202 "Update in progress" are retried with the backoff of the Poller,
+// and async sections are started and polled. A failing section does not affect the others.
+// Duplicate sections are fetched once, unknown sections are reported as SectionFailed.
+//
+// Requests go through the client of the Poller, configure it with WithRateLimit to stay within the quotas.
+func (p *Poller) Dossier(ctx context.Context, contractorCode string, sections ...DossierSection) *Dossier {
+	if len(sections) == 0 {
+		sections = DefaultDossierSections
+	}
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	d := &Dossier{
+		ContractorCode: contractorCode,
+		Sections:       make(map[DossierSection]SectionResult),
+	}
+	yes := true
+	code := contractorCode
+
+	// all sections are validated before any is fetched, so that only the goroutines write to d
+	fetches := make(map[DossierSection]func() SectionResult, len(sections))
+	for _, section := range sections {
+		if _, ok := fetches[section]; ok {
+			continue
+		}
+		var fetch func() SectionResult
+		switch section {
+		case SectionUSR:
+			fetch = func() (res SectionResult) {
+				d.USR, res = fetchSection(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseUsrLegalPersonRegisterData, error) {
+					res, err := p.client.GetV1UsrContractorCodeWithResponse(ctx, code, &GetV1UsrContractorCodeParams{ShowCurrentData: &yes})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				if d.USR != nil {
+					res.ActualDate = timeValue(d.USR.ActualDate)
+				}
+				return res
+			}
+		case SectionOwnership:
+			fetch = func() (res SectionResult) {
+				d.Ownership, res = fetchSection(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseUsrUsrDocumentsUsrDocumentResultModel, error) {
+					res, err := p.client.GetV1UsrDocumentsUsrOwnershipStructureFileWithResponse(ctx, &GetV1UsrDocumentsUsrOwnershipStructureFileParams{Code: &code})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				if d.Ownership != nil && d.Ownership.AsOfDate != nil {
+					res.ActualDate = parseActualDate(*d.Ownership.AsOfDate)
+				}
+				return res
+			}
+		case SectionShareholders:
+			fetch = func() (res SectionResult) {
+				d.Shareholders, res = fetchSection(ctx, p, func(ctx context.Context) (int, *[]YCApiModelsResponseShareholders, error) {
+					res, err := p.client.GetV1ShareholdersContractorCodeWithResponse(ctx, code, &GetV1ShareholdersContractorCodeParams{AddHistory: &yes})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				return res
+			}
+		case SectionHistory:
+			fetch = func() (res SectionResult) {
+				d.History, res = fetchSection(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseHistoryContractorHistory, error) {
+					res, err := p.client.GetV1HistoryContractorCodeWithResponse(ctx, code)
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				return res
+			}
+		case SectionStatut:
+			fetch = func() (res SectionResult) {
+				d.Statut, res = fetchSection(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseUsrUsrDocumentsUsrDocumentResultModel, error) {
+					res, err := p.client.GetV1UsrDocumentsUsrStatutFileWithResponse(ctx, &GetV1UsrDocumentsUsrStatutFileParams{Code: &code})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				if d.Statut != nil && d.Statut.AsOfDate != nil {
+					res.ActualDate = parseActualDate(*d.Statut.AsOfDate)
+				}
+				return res
+			}
+		case SectionAdministrativeServices:
+			fetch = func() (res SectionResult) {
+				d.AdministrativeServices, res = fetchSection(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseUsrAdministrativeServicesAdministrativeServicesResultsModel, error) {
+					res, err := p.client.GetV1UsrAdministrativeServicesResultsCodeWithResponse(ctx, code)
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				return res
+			}
+		case SectionExpressAnalysis:
+			fetch = func() (res SectionResult) {
+				d.ExpressAnalysis, res = fetchSection(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseExpressAnalysis, error) {
+					res, err := p.client.GetV1ExpressAnalysisContractorCodeWithResponse(ctx, code, &GetV1ExpressAnalysisContractorCodeParams{ShowCurrentData: &yes})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				if d.ExpressAnalysis != nil {
+					res.ActualDate = timeValue(d.ExpressAnalysis.ActualDate)
+				}
+				return res
+			}
+		case SectionFinmon:
+			fetch = func() (res SectionResult) {
+				d.Finmon, res = fetchSection(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseExpressAnalysisFinMonFactors, error) {
+					res, err := p.client.GetV1ExpressAnalysisFinmonContractorCodeWithResponse(ctx, code, &GetV1ExpressAnalysisFinmonContractorCodeParams{ShowCurrentData: &yes})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				if d.Finmon != nil {
+					res.ActualDate = timeValue(d.Finmon.ActualDate)
+				}
+				return res
+			}
+		case SectionAffiliates:
+			fetch = func() SectionResult {
+				var err error
+				d.Affiliates, err = p.AffiliatesQuery(ctx, PostV1AffiliatesQueryJSONRequestBody{ContractorCode: code})
+				return asyncSectionResult(err)
+			}
+		case SectionEncumbrances:
+			fetch = func() SectionResult {
+				var err error
+				d.Encumbrances, err = p.Encumbrances(ctx, code)
+				return asyncSectionResult(err)
+			}
+		case SectionRealEstate:
+			fetch = func() SectionResult {
+				var err error
+				d.RealEstate, err = p.RealEstate(ctx, code)
+				return asyncSectionResult(err)
+			}
+		default:
+			d.Sections[section] = SectionResult{Status: SectionFailed, Err: fmt.Errorf("unknown section %q", section)}
+			continue
+		}
+		fetches[section] = fetch
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for section, fetch := range fetches {
+		wg.Go(func() {
+			res := fetch()
+			mu.Lock()
+			d.Sections[section] = res
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	return d
+}
+
+// fetchSection calls a synchronous endpoint, retrying 202 responses with the backoff of the Poller.
+// call returns the status of the response and its typed result.
+func fetchSection[T any](ctx context.Context, p *Poller, call func(ctx context.Context) (int, *T, error)) (*T, SectionResult) {
+	_, v, err := awaitResult(ctx, p, false, call)
+	if err != nil {
+		return nil, sectionError(err)
+	}
+	return v, SectionResult{Status: SectionOK}
+}
+
+// asyncSectionResult describes the outcome of a section fetched by the Poller.
+func asyncSectionResult(err error) SectionResult {
+	if err != nil {
+		return sectionError(err)
+	}
+	return SectionResult{Status: SectionOK}
+}
+
+// sectionError describes a section that failed with err. It is only in progress
+// if the last response was a 202, not if the context expired during a request.
+func sectionError(err error) SectionResult {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return SectionResult{Status: SectionNotFound, Err: err}
+	case errors.Is(err, ErrInProgress):
+		return SectionResult{Status: SectionInProgress, Err: err}
+	}
+	return SectionResult{Status: SectionFailed, Err: err}
+}
+
+// timeValue returns *t, or the zero time if t is nil.
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -0,0 +1,125 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPoller_Dossier(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/00032112": {
+			{status: http.StatusAccepted},
+			{status: http.StatusOK, body: `{"actualDate":"2025-03-01T10:00:00Z","code":"00032112"}`},
+		},
+		"/v1/history/00032112":           {{status: http.StatusOK, body: `{}`}},
+		"/v1/usrDocuments/usrStatutFile": {{status: http.StatusOK, body: `{"asOfDate":"2019-06-03T21:00:00Z","name":"statut.pdf"}`}},
+		"/v1/expressAnalysis/00032112":   {{status: http.StatusInternalServerError}},
+		// shareholders is not scripted and answers 404
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl, WithPollBackoff(time.Millisecond, time.Millisecond, 2))
+	d := p.Dossier(t.Context(), "00032112", SectionUSR, SectionShareholders, SectionHistory, SectionExpressAnalysis, SectionStatut)
+
+	if got := d.Sections[SectionUSR]; got.Status != SectionOK || got.ActualDate.Format(time.DateOnly) != "2025-03-01" {
+		t.Fatalf("unexpected usr section: %+v", got)
+	}
+	if d.USR == nil {
+		t.Fatal("expected usr data")
+	}
+	if got := doer.count("/v1/usr/00032112"); got != 2 {
+		t.Fatalf("expected 2 usr calls, got %d", got)
+	}
+
+	if got := d.Sections[SectionShareholders]; got.Status != SectionNotFound || !errors.Is(got.Err, ErrNotFound) {
+		t.Fatalf("unexpected shareholders section: %+v", got)
+	}
+	if d.Shareholders != nil {
+		t.Fatal("expected no shareholders")
+	}
+	if got := d.Sections[SectionHistory]; got.Status != SectionOK || d.History == nil {
+		t.Fatalf("unexpected history section: %+v", got)
+	}
+	if got := d.Sections[SectionStatut]; got.Status != SectionOK || !got.ActualDate.Equal(time.Date(2019, 6, 3, 21, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected the asOfDate of the statut as its date, got %+v", got)
+	}
+	if got := d.Sections[SectionExpressAnalysis]; got.Status != SectionFailed {
+		t.Fatalf("unexpected express analysis section: %+v", got)
+	}
+	if d.Err() == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestPoller_DossierInProgress(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/00032112": {{status: http.StatusAccepted}},
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl,
+		WithPollBackoff(time.Millisecond, 2*time.Millisecond, 2),
+		WithPollTimeout(20*time.Millisecond),
+	)
+	d := p.Dossier(t.Context(), "00032112", SectionUSR)
+	if got := d.Sections[SectionUSR]; got.Status != SectionInProgress || !errors.Is(got.Err, ErrInProgress) {
+		t.Fatalf("unexpected usr section: %+v", got)
+	}
+}
+
+// hangingDoer answers no request, until its context is done.
+type hangingDoer struct{}
+
+func (hangingDoer) Do(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestPoller_DossierTimeoutIsNotInProgress(t *testing.T) {
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(hangingDoer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl, WithPollTimeout(10*time.Millisecond))
+	d := p.Dossier(t.Context(), "00032112", SectionUSR)
+	got := d.Sections[SectionUSR]
+	if got.Status != SectionFailed || errors.Is(got.Err, ErrInProgress) || !errors.Is(got.Err, context.DeadlineExceeded) {
+		t.Fatalf("expected a failed section without a 202, got %+v", got)
+	}
+}
+
+func TestPoller_DossierDuplicateAndUnknownSections(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/00032112": {{status: http.StatusOK, body: `{"code":"00032112"}`}},
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl, WithPollBackoff(time.Millisecond, time.Millisecond, 2))
+	d := p.Dossier(t.Context(), "00032112", SectionUSR, "bogus", SectionUSR, "bogus")
+
+	if got := doer.count("/v1/usr/00032112"); got != 1 {
+		t.Fatalf("expected a duplicate section to be fetched once, got %d calls", got)
+	}
+	if got := d.Sections[SectionUSR]; got.Status != SectionOK || d.USR == nil {
+		t.Fatalf("unexpected usr section: %+v", got)
+	}
+	if got := d.Sections["bogus"]; got.Status != SectionFailed || got.Err == nil {
+		t.Fatalf("unexpected unknown section: %+v", got)
+	}
+	if len(d.Sections) != 2 {
+		t.Fatalf("expected 2 sections, got %d", len(d.Sections))
+	}
+}
@@ -0,0 +1,298 @@
+package youscore
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+// EncryptionKeyHeader is the header of an encrypted cache entry that holds the id of its key.
+const EncryptionKeyHeader = "X-Youscore-Encryption-Key"
+
+// CacheKeyProvider provides the AES keys of an encrypted cache, see EncryptCache.
+// Keys must be 16, 24 or 32 bytes long (AES-128, AES-192 or AES-256).
+//
+// To rotate keys, make a new key current and keep providing the old ones by id
+// until the entries encrypted with them have expired. The old entries are only found
+// if the provider also implements CacheKeyLister, otherwise they are misses after a rotation.
+type CacheKeyProvider interface {
+	// CurrentKey returns the key used to encrypt new entries, and its id.
+	CurrentKey(ctx context.Context) (id string, key []byte, err error)
+	// Key returns the key with the given id, to decrypt existing entries.
+	Key(ctx context.Context, id string) ([]byte, error)
+}
+
+// CacheKeyLister is implemented by a CacheKeyProvider that can list its keys.
+// An encrypted cache then also looks up the entries written with keys that are no longer current.
+type CacheKeyLister interface {
+	// KeyIDs returns the ids of all keys, including the current one.
+	KeyIDs(ctx context.Context) ([]string, error)
+}
+
+// StaticKeys is a CacheKeyProvider with a fixed set of keys.
+type StaticKeys struct {
+	// Current is the id of the key used to encrypt new entries.
+	Current string
+	// Keys holds all keys by id, including the ones of older entries.
+	Keys map[string][]byte
+}
+
+// CurrentKey implements CacheKeyProvider.
+func (k StaticKeys) CurrentKey(ctx context.Context) (string, []byte, error) {
+	key, err := k.Key(ctx, k.Current)
+	return k.Current, key, err
+}
+
+// Key implements CacheKeyProvider.
+func (k StaticKeys) Key(_ context.Context, id string) ([]byte, error) {
+	key, ok := k.Keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", id)
+	}
+	return key, nil
+}
+
+// KeyIDs implements CacheKeyLister.
+func (k StaticKeys) KeyIDs(context.Context) ([]string, error) {
+	return slices.Sorted(maps.Keys(k.Keys)), nil
+}
+
+// EncryptCache wraps a Cache so that responses are encrypted at rest with AES-GCM.
+// The body, the headers and the INN and passport of the metadata are encrypted, the rest of the metadata is kept
+// in plain text so that backends can still expire and invalidate entries.
+// The backend only gets the path of the request URL: the query, which may hold personal data
+// (e.g. the name or INN of an individual), is not passed on. The cache key, a plain hash of the URL,
+// is replaced by an HMAC keyed by the encryption key, so that the query cannot be guessed from it either.
+//
+// Entries that cannot be decrypted (unknown key, tampered or unencrypted) are treated as misses.
+// Use EncryptContextCache with WithContextCache to get these errors reported by WithCacheErrorHook.
+func EncryptCache(cache Cache, keys CacheKeyProvider) Cache {
+	return encryptedCache{
+		cache: AdaptCache(cache),
+		keys:  keys,
+	}
+}
+
+// EncryptContextCache is like EncryptCache, for a ContextCache.
+func EncryptContextCache(cache ContextCache, keys CacheKeyProvider) ContextCache {
+	return encryptedContextCache{encryptedCache{
+		cache: cache,
+		keys:  keys,
+	}}
+}
+
+type encryptedCache struct {
+	cache ContextCache
+	keys  CacheKeyProvider
+}
+
+// encryptedPayload is the plain text of an encrypted entry.
+type encryptedPayload struct {
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+	INN      string      `json:"inn,omitempty"`
+	Passport string      `json:"passport,omitempty"`
+}
+
+func (c encryptedCache) Get(url string, key string) (CachedResponse, bool) {
+	resp, ok, err := c.get(context.Background(), url, key)
+	return resp, ok && err == nil
+}
+
+func (c encryptedCache) Set(url string, key string, resp CachedResponse) {
+	_ = c.set(context.Background(), url, key, resp)
+}
+
+func (c encryptedCache) Delete(url string, key string) {
+	_ = c.delete(context.Background(), url, key)
+}
+
+type encryptedContextCache struct {
+	encryptedCache
+}
+
+func (c encryptedContextCache) Get(ctx context.Context, url string, key string) (CachedResponse, bool, error) {
+	return c.get(ctx, url, key)
+}
+
+func (c encryptedContextCache) Set(ctx context.Context, url string, key string, resp CachedResponse) error {
+	return c.set(ctx, url, key, resp)
+}
+
+func (c encryptedContextCache) Delete(ctx context.Context, url string, key string) error {
+	return c.delete(ctx, url, key)
+}
+
+func (c encryptedCache) get(ctx context.Context, url string, key string) (CachedResponse, bool, error) {
+	backendKeys, err := c.backendKeys(ctx, key)
+	if err != nil {
+		return CachedResponse{}, false, fmt.Errorf("decrypt: %w", err)
+	}
+	var (
+		stored     CachedResponse
+		ok         bool
+		backendKey string
+	)
+	for _, backendKey = range backendKeys {
+		stored, ok, err = c.cache.Get(ctx, redactURL(url), backendKey)
+		if err != nil {
+			return CachedResponse{}, false, err
+		}
+		if ok {
+			break
+		}
+	}
+	if !ok {
+		return CachedResponse{}, false, nil
+	}
+
+	keyID := stored.Header.Get(EncryptionKeyHeader)
+	if keyID == "" {
+		return CachedResponse{}, false, errors.New("decrypt: entry is not encrypted")
+	}
+	aesKey, err := c.keys.Key(ctx, keyID)
+	if err != nil {
+		return CachedResponse{}, false, fmt.Errorf("decrypt: %w", err)
+	}
+	gcm, err := newGCM(aesKey)
+	if err != nil {
+		return CachedResponse{}, false, fmt.Errorf("decrypt: %w", err)
+	}
+	if len(stored.Body) < gcm.NonceSize() {
+		return CachedResponse{}, false, errors.New("decrypt: entry too short")
+	}
+	nonce, ciphertext := stored.Body[:gcm.NonceSize()], stored.Body[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, []byte(backendKey))
+	if err != nil {
+		return CachedResponse{}, false, fmt.Errorf("decrypt: %w", err)
+	}
+
+	var payload encryptedPayload
+	if err := json.Unmarshal(plaintext, &payload); err != nil {
+		return CachedResponse{}, false, fmt.Errorf("decrypt: %w", err)
+	}
+	resp := CachedResponse{
+		StatusCode: stored.StatusCode,
+		Header:     payload.Header,
+		Body:       payload.Body,
+		Metadata:   stored.Metadata,
+	}
+	resp.Metadata.INN = payload.INN
+	resp.Metadata.Passport = payload.Passport
+	return resp, true, nil
+}
+
+func (c encryptedCache) set(ctx context.Context, url string, key string, resp CachedResponse) error {
+	keyID, aesKey, err := c.keys.CurrentKey(ctx)
+	if err != nil {
+		return fmt.Errorf("encrypt: %w", err)
+	}
+	gcm, err := newGCM(aesKey)
+	if err != nil {
+		return fmt.Errorf("encrypt: %w", err)
+	}
+	plaintext, err := json.Marshal(encryptedPayload{
+		Header:   resp.Header,
+		Body:     resp.Body,
+		INN:      resp.Metadata.INN,
+		Passport: resp.Metadata.Passport,
+	})
+	if err != nil {
+		return fmt.Errorf("encrypt: %w", err)
+	}
+
+	// the cache key is authenticated, so that entries cannot be swapped between keys
+	backendKey := hmacCacheKey(aesKey, key)
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("encrypt: %w", err)
+	}
+	stored := CachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     http.Header{EncryptionKeyHeader: {keyID}},
+		Body:       gcm.Seal(nonce, nonce, plaintext, []byte(backendKey)),
+		Metadata:   resp.Metadata,
+	}
+	stored.Metadata.INN = ""
+	stored.Metadata.Passport = ""
+	return c.cache.Set(ctx, redactURL(url), backendKey, stored)
+}
+
+func (c encryptedCache) delete(ctx context.Context, url string, key string) error {
+	backendKeys, err := c.backendKeys(ctx, key)
+	if err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+	var errs []error
+	for _, backendKey := range backendKeys {
+		errs = append(errs, c.cache.Delete(ctx, redactURL(url), backendKey))
+	}
+	return errors.Join(errs...)
+}
+
+// backendKeys returns the keys an entry of key may be stored under in the backend:
+// first the one of the current encryption key, then those of the other keys of a CacheKeyLister.
+func (c encryptedCache) backendKeys(ctx context.Context, key string) ([]string, error) {
+	currentID, aesKey, err := c.keys.CurrentKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backendKeys := []string{hmacCacheKey(aesKey, key)}
+	lister, ok := c.keys.(CacheKeyLister)
+	if !ok {
+		return backendKeys, nil
+	}
+	ids, err := lister.KeyIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if id == currentID {
+			continue
+		}
+		aesKey, err := c.keys.Key(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		backendKeys = append(backendKeys, hmacCacheKey(aesKey, key))
+	}
+	return backendKeys, nil
+}
+
+// hmacCacheKey derives the backend key of an encrypted entry from its cache key.
+func hmacCacheKey(aesKey []byte, key string) string {
+	mac := hmac.New(sha256.New, aesKey)
+	mac.Write([]byte("youscore cache key\x00"))
+	mac.Write([]byte(key))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// redactURL drops the query and fragment of rawURL, keeping the scheme, host and path.
+// The entry stays identified by its cache key, which covers the full URL.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	u.RawQuery, u.Fragment, u.RawFragment, u.User = "", "", "", nil
+	return u.String()
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
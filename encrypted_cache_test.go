@@ -0,0 +1,147 @@
+package youscore
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestEncryptCache(t *testing.T) {
+	backend := newMapCache()
+	keys := StaticKeys{
+		Current: "k1",
+		Keys:    map[string][]byte{"k1": bytes.Repeat([]byte{1}, 32)},
+	}
+	cache := EncryptCache(backend, keys)
+
+	resp := CachedResponse{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       []byte(`{"passport":"AB123456"}`),
+		Metadata:   CacheMetadata{Operation: "GetV1Passports", INN: "1234567890", Passport: "AB123456"},
+	}
+	cache.Set("url", "key", resp)
+
+	// the backend key is an HMAC of the cache key, which is a plain hash of the URL
+	if _, ok := backend.store["key"]; ok || len(backend.store) != 1 {
+		t.Fatalf("expected the entry to be stored under a derived key, got %v", slices.Collect(maps.Keys(backend.store)))
+	}
+	stored := backend.store[hmacCacheKey(keys.Keys["k1"], "key")]
+	if bytes.Contains(stored.Body, []byte("AB123456")) || stored.Header.Get("Content-Type") != "" || stored.Metadata.INN != "" || stored.Metadata.Passport != "" {
+		t.Fatalf("expected the entry to be encrypted, got %+v", stored)
+	}
+	if stored.Metadata.Operation != "GetV1Passports" {
+		t.Fatalf("expected the operation to stay readable, got %q", stored.Metadata.Operation)
+	}
+
+	got, ok := cache.Get("url", "key")
+	if !ok || !bytes.Equal(got.Body, resp.Body) || got.Header.Get("Content-Type") != "application/json" || got.Metadata.INN != "1234567890" || got.Metadata.Passport != "AB123456" {
+		t.Fatalf("unexpected decrypted entry: %+v, %v", got, ok)
+	}
+
+	// entries cannot be moved to another cache key
+	backend.store[hmacCacheKey(keys.Keys["k1"], "other")] = stored
+	if _, ok := cache.Get("url", "other"); ok {
+		t.Fatal("expected a swapped entry not to decrypt")
+	}
+}
+
+func TestEncryptCache_KeyRotation(t *testing.T) {
+	backend := newMapCache()
+	keys := StaticKeys{
+		Current: "k1",
+		Keys: map[string][]byte{
+			"k1": bytes.Repeat([]byte{1}, 32),
+			"k2": bytes.Repeat([]byte{2}, 16),
+		},
+	}
+	EncryptCache(backend, keys).Set("url", "old", CachedResponse{StatusCode: http.StatusOK, Body: []byte("old")})
+
+	// rotate: new entries use k2, old entries are still readable with k1
+	keys.Current = "k2"
+	cache := EncryptContextCache(AdaptCache(backend), keys)
+	ctx := t.Context()
+	if err := cache.Set(ctx, "url", "new", CachedResponse{StatusCode: http.StatusOK, Body: []byte("new")}); err != nil {
+		t.Fatal(err)
+	}
+	if id := backend.store[hmacCacheKey(keys.Keys["k2"], "new")].Header.Get(EncryptionKeyHeader); id != "k2" {
+		t.Fatalf("expected the new entry to use k2, got %q", id)
+	}
+	for _, key := range []string{"old", "new"} {
+		got, ok, err := cache.Get(ctx, "url", key)
+		if err != nil || !ok || string(got.Body) != key {
+			t.Fatalf("%s: unexpected entry %+v, %v, %v", key, got, ok, err)
+		}
+	}
+
+	// a provider that cannot list its keys only finds the entries of the current key
+	cache = EncryptContextCache(AdaptCache(backend), currentKeyOnly{keys})
+	if _, ok, err := cache.Get(ctx, "url", "old"); ok || err != nil {
+		t.Fatalf("expected a miss for an entry of an old key, got %v, %v", ok, err)
+	}
+
+	// retire k1
+	delete(keys.Keys, "k1")
+	cache = EncryptContextCache(AdaptCache(backend), keys)
+	if _, ok, err := cache.Get(ctx, "url", "old"); ok || err != nil {
+		t.Fatalf("expected a miss for a retired key, got %v, %v", ok, err)
+	}
+}
+
+// currentKeyOnly hides the CacheKeyLister of a provider.
+type currentKeyOnly struct {
+	CacheKeyProvider
+}
+
+// urlRecordingCache is a ContextCache that records the URLs it is called with.
+type urlRecordingCache struct {
+	*mapCache
+	urls []string
+}
+
+func (c *urlRecordingCache) Get(_ context.Context, url string, key string) (CachedResponse, bool, error) {
+	c.urls = append(c.urls, url)
+	resp, ok := c.mapCache.Get(url, key)
+	return resp, ok, nil
+}
+
+func (c *urlRecordingCache) Set(_ context.Context, url string, key string, resp CachedResponse) error {
+	c.urls = append(c.urls, url)
+	c.mapCache.Set(url, key, resp)
+	return nil
+}
+
+func (c *urlRecordingCache) Delete(_ context.Context, url string, key string) error {
+	c.urls = append(c.urls, url)
+	return nil
+}
+
+func TestEncryptCache_RedactsURL(t *testing.T) {
+	backend := &urlRecordingCache{mapCache: newMapCache()}
+	keys := StaticKeys{Current: "k1", Keys: map[string][]byte{"k1": bytes.Repeat([]byte{1}, 32)}}
+	cache := EncryptContextCache(backend, keys)
+
+	const url = "https://api.youscore.com.ua/v1/individualsRnboSanctions?LastName=Shevchenko&INN=1234567890"
+	ctx := t.Context()
+	if err := cache.Set(ctx, url, "key", CachedResponse{StatusCode: http.StatusOK}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := cache.Get(ctx, url, "key"); !ok || err != nil {
+		t.Fatalf("expected a hit, got %v, %v", ok, err)
+	}
+	if err := cache.Delete(ctx, url, "key"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, got := range backend.urls {
+		if got != "https://api.youscore.com.ua/v1/individualsRnboSanctions" {
+			t.Fatalf("expected the backend to get the URL without its query, got %q", got)
+		}
+	}
+	if len(backend.urls) != 3 {
+		t.Fatalf("expected 3 backend calls, got %d", len(backend.urls))
+	}
+}
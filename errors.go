@@ -0,0 +1,117 @@
+package youscore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors for the non-200 statuses of the API. Use errors.Is to check for them,
+// and errors.As with *APIError to get the status code, body and validation details.
+var (
+	// ErrNotFound is returned for 404 responses.
+	ErrNotFound = errors.New("youscore: not found")
+	// ErrInProgress is returned for 202 "Update in progress" responses.
+	ErrInProgress = errors.New("youscore: update in progress")
+	// ErrBadRequest is returned for 400 responses, see APIError.Details.
+	ErrBadRequest = errors.New("youscore: bad request")
+	// ErrRateLimited is returned for 429 "Too Many Requests" responses.
+	ErrRateLimited = errors.New("youscore: too many requests")
+	// ErrUnauthorized is returned for 401 and 403 responses.
+	ErrUnauthorized = errors.New("youscore: unauthorized")
+)
+
+// APIError describes a response with an unexpected status.
+type APIError struct {
+	StatusCode int
+	Body       []byte
+	// Details holds the validation messages of a 400 response, if the body carries them.
+	Details map[string]string
+}
+
+func (e *APIError) Error() string {
+	if len(e.Details) > 0 {
+		return fmt.Sprintf("youscore: status %d: %v", e.StatusCode, e.Details)
+	}
+	return fmt.Sprintf("youscore: status %d", e.StatusCode)
+}
+
+// Is reports whether the status of the error matches one of the sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrInProgress:
+		return e.StatusCode == http.StatusAccepted
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+// CheckResponse returns nil for a 200 response, and an *APIError for any other status.
+func CheckResponse(resp *http.Response, body []byte) error {
+	if resp == nil {
+		return errors.New("youscore: no response")
+	}
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+	}
+	if resp.StatusCode == http.StatusBadRequest {
+		apiErr.Details = parseErrorDetails(body)
+	}
+	return apiErr
+}
+
+// parseErrorDetails extracts the validation messages from a 400 body.
+// Both a flat {"field": "message"} object and {"errors": {"field": ["message"]}} are understood.
+func parseErrorDetails(body []byte) map[string]string {
+	var flat map[string]string
+	if err := json.Unmarshal(body, &flat); err == nil && len(flat) > 0 {
+		return flat
+	}
+
+	var problem struct {
+		Title  string              `json:"title"`
+		Errors map[string][]string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &problem); err != nil {
+		return nil
+	}
+	details := make(map[string]string)
+	for field, msgs := range problem.Errors {
+		if len(msgs) > 0 {
+			details[field] = msgs[0]
+		}
+	}
+	if len(details) == 0 && problem.Title != "" {
+		details["title"] = problem.Title
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return details
+}
+
+// Result turns the return values of a generated *WithResponse method into (value, error):
+//
+//	usr, err := youscore.Result(cl.GetV1UsrContractorCodeWithResponse(ctx, code, nil))
+//	if errors.Is(err, youscore.ErrInProgress) {
+//		// try again later
+//	}
+func Result[T any, R interface{ Result() (*T, error) }](res R, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return res.Result()
+}
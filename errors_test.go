@@ -0,0 +1,75 @@
+package youscore
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestResult_Errors(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/00000001": {{status: http.StatusOK, body: `{"code":"00000001"}`}},
+		"/v1/usr/00000002": {{status: http.StatusAccepted}},
+		"/v1/usr/00000003": {{status: http.StatusBadRequest, body: `{"contractorCode":"invalid code"}`}},
+		"/v1/usr/00000004": {{status: http.StatusTooManyRequests}},
+		"/v1/usr/00000005": {{status: http.StatusUnauthorized}},
+		// unscripted paths answer 404
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := t.Context()
+
+	usr, err := Result(cl.GetV1UsrContractorCodeWithResponse(ctx, "00000001", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usr.Code == nil || *usr.Code != "00000001" {
+		t.Fatalf("unexpected value: %+v", usr)
+	}
+
+	tests := []struct {
+		code string
+		want error
+	}{
+		{"00000002", ErrInProgress},
+		{"00000003", ErrBadRequest},
+		{"00000004", ErrRateLimited},
+		{"00000005", ErrUnauthorized},
+		{"00000006", ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			_, err := Result(cl.GetV1UsrContractorCodeWithResponse(ctx, tt.code, nil))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	_, err = Result(cl.GetV1UsrContractorCodeWithResponse(ctx, "00000003", nil))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.Details["contractorCode"] != "invalid code" {
+		t.Fatalf("unexpected details: %v", apiErr.Details)
+	}
+}
+
+func TestParseErrorDetails(t *testing.T) {
+	got := parseErrorDetails([]byte(`{"title":"One or more validation errors occurred.","errors":{"LastName":["The LastName field is required."]}}`))
+	if got["LastName"] != "The LastName field is required." {
+		t.Fatalf("unexpected details: %v", got)
+	}
+	if got := parseErrorDetails([]byte(`not json`)); got != nil {
+		t.Fatalf("expected nil details, got %v", got)
+	}
+}
+
+func TestErrResultNotFound(t *testing.T) {
+	if !errors.Is(ErrResultNotFound, ErrNotFound) {
+		t.Fatal("ErrResultNotFound should match ErrNotFound")
+	}
+}
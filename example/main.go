@@ -3,12 +3,10 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
-	"sync"
-	"time"
 
 	"github.com/fritzkeyzer/goyouscore"
 )
@@ -26,8 +24,12 @@ func main() {
 		log.Fatal("ERROR: missing required API keys:", apiKeys)
 	}
 
-	// create a silly file based cache (for the sake of this demo)
-	cache := newDumbFileCache()
+	// create a file based cache, persisted across runs
+	cache, err := youscore.NewDiskCache("cache", youscore.WithDiskCacheMaxBytes(256<<20))
+	if err != nil {
+		log.Fatal("ERROR: create cache:", err)
+	}
+	go cache.Run(ctx)
 
 	// create simple usage tracker
 	totalUsage := new(usage)
@@ -48,106 +50,106 @@ func main() {
 	contractorCode := "08215600"
 
 	// Example: Look up registration data (USR) for a company by its EDRPOU code
-	usrResp, err := cl.GetV1UsrContractorCodeWithResponse(ctx, contractorCode, &youscore.GetV1UsrContractorCodeParams{
+	usr, err := youscore.Result(cl.GetV1UsrContractorCodeWithResponse(ctx, contractorCode, &youscore.GetV1UsrContractorCodeParams{
 		ShowCurrentData: ptr(true),
-	})
-	if err != nil {
+	}))
+	switch {
+	case errors.Is(err, youscore.ErrInProgress):
+		log.Println("Get USR: update in progress, try again later")
+	case err != nil:
 		log.Fatal("ERROR: get USR:", err)
-	}
-	log.Println("Get USR status:", usrResp.StatusCode())
-	if usrResp.StatusCode() == http.StatusOK {
-		//log.Println("Get USR:", toJSUtil(usrResp.JSON200))
-		dumpJSUtil(contractorCode+"_usr", usrResp.JSON200)
+	default:
+		dumpJSUtil(contractorCode+"_usr", usr)
 	}
 
 	// Example: Look up ownership
-	usrOwnershipResp, err := cl.GetV1UsrDocumentsUsrOwnershipStructureFileWithResponse(ctx, &youscore.GetV1UsrDocumentsUsrOwnershipStructureFileParams{
+	usrOwnership, err := youscore.Result(cl.GetV1UsrDocumentsUsrOwnershipStructureFileWithResponse(ctx, &youscore.GetV1UsrDocumentsUsrOwnershipStructureFileParams{
 		Code: &contractorCode,
-	})
-	if err != nil {
+	}))
+	switch {
+	case errors.Is(err, youscore.ErrInProgress):
+		log.Println("Get ownership: update in progress, try again later")
+	case err != nil:
 		log.Fatal("ERROR: get ownership:", err)
-	}
-	log.Println("Get ownership status:", usrOwnershipResp.StatusCode())
-	if usrOwnershipResp.StatusCode() == http.StatusOK {
-		//log.Println("Get ownership:", toJSUtil(usrOwnershipResp.JSON200))
-		dumpJSUtil(contractorCode+"_ownership", usrOwnershipResp.JSON200)
+	default:
+		dumpJSUtil(contractorCode+"_ownership", usrOwnership)
 	}
 
 	// Example: Look up shareholders
 	yes := true
-	shareholdersResp, err := cl.GetV1ShareholdersContractorCodeWithResponse(ctx, contractorCode, &youscore.GetV1ShareholdersContractorCodeParams{
+	shareholders, err := youscore.Result(cl.GetV1ShareholdersContractorCodeWithResponse(ctx, contractorCode, &youscore.GetV1ShareholdersContractorCodeParams{
 		AddHistory: &yes,
-	})
-	if err != nil {
+	}))
+	switch {
+	case errors.Is(err, youscore.ErrInProgress):
+		log.Println("Get shareholders: update in progress, try again later")
+	case err != nil:
 		log.Fatal("ERROR: get shareholders:", err)
-	}
-	log.Println("Get shareholders status:", shareholdersResp.StatusCode())
-	if shareholdersResp.StatusCode() == http.StatusOK {
-		//log.Println("Get shareholders:", toJSUtil(shareholdersResp.JSON200))
-		dumpJSUtil(contractorCode+"_shareholders", shareholdersResp.JSON200)
+	default:
+		dumpJSUtil(contractorCode+"_shareholders", shareholders)
 	}
 
 	// Example: Look up history
-	historyResp, err := cl.GetV1HistoryContractorCodeWithResponse(ctx, contractorCode)
-	if err != nil {
+	history, err := youscore.Result(cl.GetV1HistoryContractorCodeWithResponse(ctx, contractorCode))
+	switch {
+	case errors.Is(err, youscore.ErrInProgress):
+		log.Println("Get history: update in progress, try again later")
+	case err != nil:
 		log.Fatal("ERROR: get history:", err)
-	}
-	log.Println("Get history status:", historyResp.StatusCode())
-	if historyResp.StatusCode() == http.StatusOK {
-		//log.Println("Get history:", toJSUtil(historyResp.JSON200))
-		dumpJSUtil(contractorCode+"_history", historyResp.JSON200)
+	default:
+		dumpJSUtil(contractorCode+"_history", history)
 	}
 
 	// Example: Look up status
-	usrStatutResp, err := cl.GetV1UsrDocumentsUsrStatutFileWithResponse(ctx, &youscore.GetV1UsrDocumentsUsrStatutFileParams{
+	usrStatut, err := youscore.Result(cl.GetV1UsrDocumentsUsrStatutFileWithResponse(ctx, &youscore.GetV1UsrDocumentsUsrStatutFileParams{
 		Code: &contractorCode,
-	})
-	if err != nil {
+	}))
+	switch {
+	case errors.Is(err, youscore.ErrInProgress):
+		log.Println("Get usr statut: update in progress, try again later")
+	case err != nil:
 		log.Fatal("ERROR: get usr statut:", err)
-	}
-	log.Println("Get usr statut status:", usrStatutResp.StatusCode())
-	if usrStatutResp.StatusCode() == http.StatusOK {
-		//log.Println("Get usr statut:", toJSUtil(usrStatutResp.JSON200))
-		dumpJSUtil(contractorCode+"_usrStat", usrStatutResp.JSON200)
+	default:
+		dumpJSUtil(contractorCode+"_usrStat", usrStatut)
 	}
 
 	// Example: Look up admin services
-	usrAdminResp, err := cl.GetV1UsrAdministrativeServicesResultsCodeWithResponse(ctx, contractorCode)
-	if err != nil {
+	usrAdmin, err := youscore.Result(cl.GetV1UsrAdministrativeServicesResultsCodeWithResponse(ctx, contractorCode))
+	switch {
+	case errors.Is(err, youscore.ErrInProgress):
+		log.Println("Get usr administrative services: update in progress, try again later")
+	case err != nil:
 		log.Fatal("ERROR: get usr administrative services:", err)
-	}
-	log.Println("Get usr administrative services status:", usrAdminResp.StatusCode())
-	if usrAdminResp.StatusCode() == http.StatusOK {
-		//log.Println("Get usr administrative services:", toJSUtil(usrAdminResp.JSON200))
-		dumpJSUtil(contractorCode+"_usrAdministrativeServices", usrAdminResp.JSON200)
+	default:
+		dumpJSUtil(contractorCode+"_usrAdministrativeServices", usrAdmin)
 	}
 
 	// Example: Check express analysis for a company
-	expressAnalysisResp, err := cl.GetV1ExpressAnalysisContractorCodeWithResponse(ctx, contractorCode, &youscore.GetV1ExpressAnalysisContractorCodeParams{
+	expressAnalysis, err := youscore.Result(cl.GetV1ExpressAnalysisContractorCodeWithResponse(ctx, contractorCode, &youscore.GetV1ExpressAnalysisContractorCodeParams{
 		ShowCurrentData: &yes,
 		ShowPrompt:      &yes,
-	})
-	if err != nil {
+	}))
+	switch {
+	case errors.Is(err, youscore.ErrInProgress):
+		log.Println("Get express analysis: update in progress, try again later")
+	case err != nil:
 		log.Fatal("ERROR: get express analysis:", err)
-	}
-	log.Println("Get express analysis status:", expressAnalysisResp.StatusCode())
-	if expressAnalysisResp.StatusCode() == http.StatusOK {
-		//log.Println("Get express analysis:", toJSUtil(expressAnalysisResp.JSON200))
-		dumpJSUtil(contractorCode+"_expressAnalysis", expressAnalysisResp.JSON200)
+	default:
+		dumpJSUtil(contractorCode+"_expressAnalysis", expressAnalysis)
 	}
 
 	// Example: Check express analysis finmon for a company
-	expressAnalysisFinMonResp, err := cl.GetV1ExpressAnalysisFinmonContractorCodeWithResponse(ctx, contractorCode, &youscore.GetV1ExpressAnalysisFinmonContractorCodeParams{
+	expressAnalysisFinMon, err := youscore.Result(cl.GetV1ExpressAnalysisFinmonContractorCodeWithResponse(ctx, contractorCode, &youscore.GetV1ExpressAnalysisFinmonContractorCodeParams{
 		ShowCurrentData: &yes,
 		ShowPrompt:      &yes,
-	})
-	if err != nil {
+	}))
+	switch {
+	case errors.Is(err, youscore.ErrInProgress):
+		log.Println("Get express analysis finmon: update in progress, try again later")
+	case err != nil:
 		log.Fatal("ERROR: get express analysis finmon:", err)
-	}
-	log.Println("Get express analysis finmon status:", expressAnalysisFinMonResp.StatusCode())
-	if expressAnalysisFinMonResp.StatusCode() == http.StatusOK {
-		//log.Println("Get express analysis finmon:", toJSUtil(expressAnalysisFinMonResp.JSON200))
-		dumpJSUtil(contractorCode+"_expressAnalysisFinMon", expressAnalysisFinMonResp.JSON200)
+	default:
+		dumpJSUtil(contractorCode+"_expressAnalysisFinMon", expressAnalysisFinMon)
 	}
 
 	//// Example: Check sanctions for a company
@@ -166,122 +168,13 @@ func main() {
 	// Example: Check rate limits (for all non-blank keys - using the custom utility)
 	rateLimitsResp, err := youscore.CheckRateLimits(ctx, apiKeys)
 	if err != nil {
-		log.Fatal("ERROR: check rate limits:", err)
+		// the limits of the keys that succeeded are still returned
+		log.Println("ERROR: check rate limits:", err)
 	}
 	log.Println("Rate limits:", toJSUtil(rateLimitsResp))
 	dumpJSUtil("rate_limits", rateLimitsResp)
 }
 
-// ---------------------------
-// --- SILLY CACHE EXAMPLE ---
-// ---------------------------
-
-// NOTE: This file-backed JSON cache is a dumb example implementation.
-// A realistic application should use a more sophisticated caching mechanism
-// (e.g. Redis, SQLite, bbolt, or an in-memory LRU cache with proper eviction).
-// Writing the entire cache to a JSON file on every update is computationally
-// wasteful and does not scale, but it ensures the cache is persisted even if
-// the program exits, errors, or is cancelled.
-
-const (
-	cacheFile = "cache.json"
-	cacheTTL  = 7 * 24 * time.Hour // cached entries expire after 7 days
-)
-
-// dumbFileCache is a map-based cache that persists to a JSON file on every write.
-// It implements the youscore.Cache interface.
-type dumbFileCache struct {
-	mu      sync.Mutex
-	entries map[string]cacheEntry
-}
-
-// newDumbFileCache creates a new dumbFileCache, loading existing entries from the cache file.
-func newDumbFileCache() *dumbFileCache {
-	c := &dumbFileCache{
-		entries: make(map[string]cacheEntry),
-	}
-	c.load()
-	return c
-}
-
-// cacheEntry represents a single cached HTTP response with metadata.
-type cacheEntry struct {
-	URL       string                  `json:"url"`
-	Key       string                  `json:"key"`
-	Timestamp time.Time               `json:"timestamp"`
-	Response  youscore.CachedResponse `json:"response"`
-}
-
-// load reads the cache file from disk into memory.
-func (c *dumbFileCache) load() {
-	data, err := os.ReadFile(cacheFile)
-	if err != nil {
-		return // file doesn't exist yet or can't be read — start with empty cache
-	}
-
-	var entries map[string]cacheEntry
-	if err := json.Unmarshal(data, &entries); err != nil {
-		return // corrupted file — start fresh
-	}
-
-	c.entries = entries
-}
-
-// save writes the entire cache map to the JSON file.
-func (c *dumbFileCache) save() {
-	data, err := json.MarshalIndent(c.entries, "", "  ")
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "cache: failed to marshal: %v\n", err)
-		return
-	}
-
-	if err := os.WriteFile(cacheFile, data, 0644); err != nil {
-		fmt.Fprintf(os.Stderr, "cache: failed to write file: %v\n", err)
-	}
-}
-
-// Get retrieves a cached response if it exists and is not expired.
-func (c *dumbFileCache) Get(url string, key string) (youscore.CachedResponse, bool) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	entry, ok := c.entries[key]
-	if !ok {
-		log.Println("cache miss:", key)
-		return youscore.CachedResponse{}, false
-	}
-
-	if time.Since(entry.Timestamp) > cacheTTL {
-		log.Println("cache miss:", key)
-		delete(c.entries, key)
-		c.save()
-		return youscore.CachedResponse{}, false
-	}
-
-	log.Println("cache hit:", key)
-	return entry.Response, true
-}
-
-// Set stores a response in the cache and immediately persists to disk.
-func (c *dumbFileCache) Set(url string, key string, resp youscore.CachedResponse) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	// skip 202 responses
-	if resp.StatusCode == 202 {
-		return
-	}
-
-	c.entries[key] = cacheEntry{
-		URL:       url,
-		Key:       key,
-		Timestamp: time.Now(),
-		Response:  resp,
-	}
-
-	c.save()
-}
-
 // ----------------------------
 // --- Simple Usage tracker ---
 // ----------------------------
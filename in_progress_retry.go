@@ -0,0 +1,155 @@
+package youscore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// InProgressPolicy controls how a 202 "Update in progress" response is retried.
+type InProgressPolicy struct {
+	// MaxAttempts is the total number of requests per call, including the first one.
+	// A value of 1 or less disables retrying.
+	MaxAttempts int
+	// Delay is the wait before the first retry. It doubles after every attempt, up to MaxDelay.
+	// Zero uses the Delay of DefaultInProgressPolicy.
+	Delay time.Duration
+	// MaxDelay caps the wait between attempts. Zero uses the MaxDelay of DefaultInProgressPolicy.
+	MaxDelay time.Duration
+	// Budget is the total time a single call may spend retrying. Zero means no limit
+	// other than MaxAttempts and the request context.
+	Budget time.Duration
+}
+
+// DefaultInProgressPolicy is used by WithInProgressRetry for paths without an override.
+var DefaultInProgressPolicy = InProgressPolicy{
+	MaxAttempts: 5,
+	Delay:       2 * time.Second,
+	MaxDelay:    15 * time.Second,
+	Budget:      time.Minute,
+}
+
+// WithInProgressRetry returns a ClientOption that wraps the underlying HTTP client
+// and re-issues a request while the API answers 202 "Update in progress",
+// e.g. GetV1UsrContractorCode while YouScore refreshes the registry.
+//
+// 202 responses carrying a resultId (the async start endpoints) and 202 responses of
+// async result endpoints (e.g. GetV1CorruptedPersonsResultId) are returned as is,
+// the Poller polls those with its own backoff.
+//
+// overrides maps a path prefix (e.g. "/v1/court/") to a policy, the longest matching prefix wins.
+// Paths without an override use DefaultInProgressPolicy.
+//
+// The client request editors are applied again on every retry, so the WithUsageTracking
+// callback fires once per attempt and can read the attempt number with RequestAttempt.
+//
+// Apply this option before WithCache, so that retries are not served from the cache.
+func WithInProgressRetry(overrides map[string]InProgressPolicy) ClientOption {
+	return func(c *Client) error {
+		inner := c.Client
+		if inner == nil {
+			inner = &http.Client{}
+		}
+		c.Client = &inProgressDoer{
+			inner:     inner,
+			client:    c,
+			overrides: overrides,
+		}
+		return nil
+	}
+}
+
+type attemptCtxKey struct{}
+
+// RequestAttempt returns the attempt number of the request carrying ctx,
+// starting at 1. It is set by WithInProgressRetry and WithRetry.
+func RequestAttempt(ctx context.Context) int {
+	if n, ok := ctx.Value(attemptCtxKey{}).(int); ok {
+		return n
+	}
+	return 1
+}
+
+type inProgressDoer struct {
+	inner     HttpRequestDoer
+	client    *Client
+	overrides map[string]InProgressPolicy
+}
+
+// policyForPath returns the override with the longest prefix matching path,
+// or DefaultInProgressPolicy. Zero delays of an override are taken from DefaultInProgressPolicy.
+func (d *inProgressDoer) policyForPath(path string) InProgressPolicy {
+	policy := DefaultInProgressPolicy
+	longest := -1
+	for prefix, p := range d.overrides {
+		if strings.HasPrefix(path, prefix) && len(prefix) > longest {
+			policy = p
+			longest = len(prefix)
+		}
+	}
+	if policy.Delay <= 0 {
+		policy.Delay = DefaultInProgressPolicy.Delay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultInProgressPolicy.MaxDelay
+	}
+	return policy
+}
+
+func (d *inProgressDoer) Do(req *http.Request) (*http.Response, error) {
+	policy := d.policyForPath(req.URL.Path)
+	if policy.MaxAttempts <= 1 {
+		return d.inner.Do(req)
+	}
+	// async results are in progress until the job is done, the Poller waits for them
+	if op, ok := LookupOperation(req.Method, req.URL.EscapedPath()); ok && op.Async == AsyncResult {
+		return d.inner.Do(req)
+	}
+
+	bodyBytes, err := bufferBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := req.Context()
+	var deadline time.Time
+	if policy.Budget > 0 {
+		deadline = time.Now().Add(policy.Budget)
+	}
+
+	delay := policy.Delay
+	for attempt := 1; ; attempt++ {
+		resp, err := d.inner.Do(req)
+		if err != nil || resp.StatusCode != http.StatusAccepted || attempt >= policy.MaxAttempts {
+			return resp, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		// async start endpoints hand out a resultId with their 202, that is not "in progress"
+		if _, err := parseResultID(body); err == nil {
+			return resp, nil
+		}
+
+		if !deadline.IsZero() && time.Now().Add(delay).After(deadline) {
+			return resp, nil
+		}
+		if err := sleepCtx(ctx, delay); err != nil {
+			return nil, err
+		}
+		delay = min(delay*2, policy.MaxDelay)
+
+		req, err = nextAttempt(d.client, req, bodyBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
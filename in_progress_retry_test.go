@@ -0,0 +1,137 @@
+package youscore
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithInProgressRetry_RetriesUntilOK(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {
+			{status: http.StatusAccepted},
+			{status: http.StatusAccepted},
+			{status: http.StatusOK, body: `{"code":"08215600"}`},
+		},
+	})
+
+	var mu sync.Mutex
+	var attempts []int
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithUsageTracking(func(ctx context.Context, _ APIType, _ string) {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts = append(attempts, RequestAttempt(ctx))
+		}),
+		WithInProgressRetry(map[string]InProgressPolicy{
+			"/v1/usr/": {MaxAttempts: 5, Delay: time.Millisecond},
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusOK || res.JSON200 == nil {
+		t.Fatalf("expected 200 with body, got %d", res.StatusCode())
+	}
+	if got := doer.count("/v1/usr/08215600"); got != 3 {
+		t.Fatalf("expected 3 calls, got %d", got)
+	}
+	if len(attempts) != 3 || attempts[0] != 1 || attempts[2] != 3 {
+		t.Fatalf("unexpected attempts seen by usage tracking: %v", attempts)
+	}
+}
+
+func TestWithInProgressRetry_MaxAttempts(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/court/08215600": {{status: http.StatusAccepted}},
+	})
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithInProgressRetry(map[string]InProgressPolicy{
+			"/v1/court/": {MaxAttempts: 2, Delay: time.Millisecond},
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cl.GetV1CourtContractorCodeWithResponse(t.Context(), "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusAccepted {
+		t.Fatalf("expected final 202, got %d", res.StatusCode())
+	}
+	if got := doer.count("/v1/court/08215600"); got != 2 {
+		t.Fatalf("expected 2 calls, got %d", got)
+	}
+}
+
+func TestWithInProgressRetry_IgnoresAsyncStart(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/corruptedPersons": {{status: http.StatusAccepted, body: `{"resultId":"abc"}`}},
+	})
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithInProgressRetry(map[string]InProgressPolicy{
+			"/v1/": {MaxAttempts: 5, Delay: time.Millisecond},
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cl.GetV1CorruptedPersonsWithResponse(t.Context(), &GetV1CorruptedPersonsParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := doer.count("/v1/corruptedPersons"); got != 1 {
+		t.Fatalf("expected async start not to be retried, got %d calls", got)
+	}
+}
+
+func TestWithInProgressRetry_WithPoller(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/corruptedPersons":    {{status: http.StatusAccepted, body: `{"resultId":"r1"}`}},
+		"/v1/corruptedPersons/r1": {{status: http.StatusAccepted}, {status: http.StatusOK, body: `{}`}},
+	})
+	// a retry of the result would wait an hour, far beyond the budget of the Poller
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithInProgressRetry(map[string]InProgressPolicy{
+			"/v1/": {MaxAttempts: 5, Delay: time.Hour, MaxDelay: time.Hour},
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl, WithPollBackoff(time.Millisecond, time.Millisecond, 2), WithPollTimeout(time.Second))
+	if _, err := p.CorruptedPersons(t.Context(), &GetV1CorruptedPersonsParams{LastName: "Шевченко", FirstName: "Тарас"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := doer.count("/v1/corruptedPersons/r1"); got != 2 {
+		t.Fatalf("expected the Poller to poll the result twice, got %d", got)
+	}
+}
+
+func TestWithInProgressRetry_ZeroDelayDefaults(t *testing.T) {
+	d := &inProgressDoer{overrides: map[string]InProgressPolicy{
+		"/v1/usr/": {MaxAttempts: 3},
+	}}
+
+	policy := d.policyForPath("/v1/usr/123")
+	if policy.MaxAttempts != 3 {
+		t.Fatalf("expected the override, got %+v", policy)
+	}
+	if policy.Delay != DefaultInProgressPolicy.Delay || policy.MaxDelay != DefaultInProgressPolicy.MaxDelay {
+		t.Fatalf("expected the default delays, got %+v", policy)
+	}
+}
@@ -0,0 +1,214 @@
+package youscore
+
+import (
+	"container/list"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NoExpiry is a TTL for responses that never expire. They are only dropped when evicted.
+const NoExpiry time.Duration = -1
+
+// DefaultTypeTTLs are the default TTLs of a MemoryCache per information type.
+// CUSTOM responses (e.g. PDF reports) do not change once generated and never expire.
+var DefaultTypeTTLs = map[APIType]time.Duration{
+	APITypeData:     24 * time.Hour,
+	APITypeAnalysis: 7 * 24 * time.Hour,
+	APITypeCustom:   NoExpiry,
+}
+
+// MemoryCacheOption configures a MemoryCache.
+type MemoryCacheOption func(*MemoryCache)
+
+// WithTypeTTL sets the TTL of responses of the given information type.
+// A TTL of zero disables caching for the type, a negative TTL (NoExpiry) keeps responses until evicted.
+func WithTypeTTL(apiType APIType, ttl time.Duration) MemoryCacheOption {
+	return func(c *MemoryCache) {
+		c.typeTTLs[apiType] = ttl
+	}
+}
+
+// WithRouteTTL sets the TTL of responses whose (sanitized) URL path starts with prefix,
+// e.g. "/v1/usr/". Route rules take precedence over the information type defaults,
+// and the longest matching prefix wins.
+// A TTL of zero disables caching for the route, a negative TTL (NoExpiry) keeps responses until evicted.
+func WithRouteTTL(prefix string, ttl time.Duration) MemoryCacheOption {
+	return func(c *MemoryCache) {
+		c.routeTTLs[prefix] = ttl
+	}
+}
+
+// MemoryCacheStats holds the counters of a MemoryCache.
+type MemoryCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	// Entries and Bytes describe the current contents of the cache.
+	Entries int
+	Bytes   int64
+}
+
+// MemoryCache is a concurrency-safe, in-memory LRU Cache bounded by the total size of the cached responses.
+// TTLs are resolved per route (see WithRouteTTL), falling back to the information type of the operation
+// (see DefaultTypeTTLs and WithTypeTTL). Expired entries are dropped on access or when evicted.
+type MemoryCache struct {
+	maxBytes  int64
+	typeTTLs  map[APIType]time.Duration
+	routeTTLs map[string]time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List // front is most recently used
+	entries map[string]*list.Element
+	bytes   int64
+	stats   MemoryCacheStats
+}
+
+type memoryCacheEntry struct {
+	key       string
+	resp      CachedResponse
+	size      int64
+	expiresAt time.Time // zero if the entry never expires
+}
+
+// NewMemoryCache creates a MemoryCache holding at most maxBytes of responses.
+func NewMemoryCache(maxBytes int64, opts ...MemoryCacheOption) *MemoryCache {
+	c := &MemoryCache{
+		maxBytes:  maxBytes,
+		typeTTLs:  make(map[APIType]time.Duration),
+		routeTTLs: make(map[string]time.Duration),
+		now:       time.Now,
+		lru:       list.New(),
+		entries:   make(map[string]*list.Element),
+	}
+	for apiType, ttl := range DefaultTypeTTLs {
+		c.typeTTLs[apiType] = ttl
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(_ string, key string) (CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return CachedResponse{}, false
+	}
+	e := el.Value.(*memoryCacheEntry)
+	if !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt) {
+		c.remove(el)
+		c.stats.Misses++
+		return CachedResponse{}, false
+	}
+	c.lru.MoveToFront(el)
+	c.stats.Hits++
+	return e.resp, true
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(rawURL string, key string, resp CachedResponse) {
+	ttl := c.ttl(rawURL)
+	if ttl == 0 {
+		return
+	}
+	size := responseSize(key, resp)
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	e := &memoryCacheEntry{
+		key:  key,
+		resp: resp,
+		size: size,
+	}
+	if ttl > 0 {
+		e.expiresAt = c.now().Add(ttl)
+	}
+	c.entries[key] = c.lru.PushFront(e)
+	c.bytes += size
+
+	for c.bytes > c.maxBytes {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+// Delete removes the entry of the given key, if any.
+func (c *MemoryCache) Delete(_ string, key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+}
+
+// Stats returns the current counters of the cache.
+func (c *MemoryCache) Stats() MemoryCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = c.lru.Len()
+	stats.Bytes = c.bytes
+	return stats
+}
+
+func (c *MemoryCache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*memoryCacheEntry)
+	delete(c.entries, e.key)
+	c.bytes -= e.size
+}
+
+// ttl resolves the TTL of a sanitized request URL.
+func (c *MemoryCache) ttl(rawURL string) time.Duration {
+	p := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		p = u.EscapedPath()
+	}
+
+	best, bestTTL := -1, time.Duration(0)
+	for prefix, ttl := range c.routeTTLs {
+		if strings.HasPrefix(p, prefix) && len(prefix) > best {
+			best, bestTTL = len(prefix), ttl
+		}
+	}
+	if best >= 0 {
+		return bestTTL
+	}
+
+	// the Cache interface does not carry the method, most operations are GETs
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		if op, ok := LookupOperation(method, p); ok {
+			return c.typeTTLs[op.InfoType]
+		}
+	}
+	return c.typeTTLs[APITypeData]
+}
+
+// responseSize approximates the memory used by a cached response.
+func responseSize(key string, resp CachedResponse) int64 {
+	size := int64(len(key) + len(resp.Body))
+	for k, vs := range resp.Header {
+		size += int64(len(k))
+		for _, v := range vs {
+			size += int64(len(v))
+		}
+	}
+	return size
+}
@@ -0,0 +1,82 @@
+package youscore
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache_TTL(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewMemoryCache(1<<20, WithRouteTTL("/v1/usr/", time.Hour), WithRouteTTL("/v1/history/", 0))
+	c.now = func() time.Time { return now }
+
+	resp := CachedResponse{StatusCode: 200, Body: []byte(`{}`)}
+	c.Set(ServerURL+"/v1/usr/08215600", "usr", resp)                                   // route rule: 1h
+	c.Set(ServerURL+"/v1/expressAnalysis/08215600", "analysis", resp)                  // ANALYTICS: 7d
+	c.Set(ServerURL+"/v1/businessPartner/08215600", "data", resp)                      // DATA: 24h
+	c.Set(ServerURL+"/v1/contractorsPdf/file/08215600", "pdf", resp)                   // CUSTOM: never expires
+	c.Set(ServerURL+"/v1/history/08215600", "history", resp)                           // route rule: not cached
+	c.Set(ServerURL+"/v1/unknown/08215600?apiKey=secret", "unknown", CachedResponse{}) // unknown: DATA
+
+	if _, ok := c.Get("", "history"); ok {
+		t.Error("expected a zero TTL not to be cached")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, ok := c.Get("", "usr"); ok {
+		t.Error("expected usr entry to expire after 1h")
+	}
+	if _, ok := c.Get("", "data"); !ok {
+		t.Error("expected data entry to be cached for 24h")
+	}
+
+	now = now.Add(24 * time.Hour)
+	if _, ok := c.Get("", "data"); ok {
+		t.Error("expected data entry to expire after 24h")
+	}
+	if _, ok := c.Get("", "analysis"); !ok {
+		t.Error("expected analysis entry to be cached for 7d")
+	}
+
+	now = now.Add(365 * 24 * time.Hour)
+	if _, ok := c.Get("", "pdf"); !ok {
+		t.Error("expected PDF reports never to expire")
+	}
+
+	stats := c.Stats()
+	if stats.Hits != 3 || stats.Misses != 3 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestMemoryCache_LRUEviction(t *testing.T) {
+	body := []byte(strings.Repeat("x", 100))
+	c := NewMemoryCache(250)
+	url := ServerURL + "/v1/usr/08215600"
+
+	c.Set(url, "a", CachedResponse{Body: body})
+	c.Set(url, "b", CachedResponse{Body: body})
+	c.Get("", "a") // a is now the most recently used
+	c.Set(url, "c", CachedResponse{Body: body})
+
+	if _, ok := c.Get("", "b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get("", key); !ok {
+			t.Errorf("expected %s to be cached", key)
+		}
+	}
+
+	// larger than the cache, never stored
+	c.Set(url, "huge", CachedResponse{Body: make([]byte, 300)})
+	if _, ok := c.Get("", "huge"); ok {
+		t.Error("expected oversized entry not to be cached")
+	}
+
+	stats := c.Stats()
+	if stats.Evictions != 1 || stats.Entries != 2 || stats.Bytes != 202 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
@@ -0,0 +1,127 @@
+// Code generated by spec/gen_operations.go from spec/swagger_en_processed.json DO NOT EDIT.
+
+package youscore
+
+// operations lists every operation in the spec.
+var operations = []Operation{
+	{Name: "GetV1AffiliatesResultId", Method: "GET", Path: "/v1/affiliates/result/{id}", InfoType: APITypeCustom, Transaction: false, Key: KeyAffiliates, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1BusinessPartnerContractorCode", Method: "GET", Path: "/v1/businessPartner/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1CompanyPersons", Method: "GET", Path: "/v1/companyPersons", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1CompanyPersonsId", Method: "GET", Path: "/v1/companyPersons/{id}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1CompanyPersonsRelations", Method: "GET", Path: "/v1/companyPersons/relations", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1ContractorsPdfFileContractorCode", Method: "GET", Path: "/v1/contractorsPdf/file/{contractorCode}", InfoType: APITypeCustom, Transaction: true, Key: KeyPDFLegalEntities, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1CorruptedPersons", Method: "GET", Path: "/v1/corruptedPersons", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1CorruptedPersonsResultId", Method: "GET", Path: "/v1/corruptedPersons/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1CourtCaseGroupContractorCode", Method: "GET", Path: "/v1/courtCaseGroup/{contractorCode}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1CourtContractorCode", Method: "GET", Path: "/v1/court/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1EncumbrancesContractorCode", Method: "GET", Path: "/v1/encumbrances/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1EncumbrancesDetailsEncumbranceId", Method: "GET", Path: "/v1/encumbrances/details/{encumbranceId}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1EncumbrancesResultResultId", Method: "GET", Path: "/v1/encumbrances/result/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1EncumbrancesResultdetailsResultId", Method: "GET", Path: "/v1/encumbrances/resultdetails/{resultId}", InfoType: APITypeAnalysis, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1EnforcementContractorCode", Method: "GET", Path: "/v1/enforcement/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1EnforcementIndividual", Method: "GET", Path: "/v1/enforcementIndividual", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1EnforcementIndividualResultId", Method: "GET", Path: "/v1/enforcementIndividual/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1ExpressAnalysisAggressorsContractorCode", Method: "GET", Path: "/v1/expressAnalysis/aggressors/{contractorCode}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1ExpressAnalysisContractorCode", Method: "GET", Path: "/v1/expressAnalysis/{contractorCode}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1ExpressAnalysisFinmonContractorCode", Method: "GET", Path: "/v1/expressAnalysis/finmon/{contractorCode}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1ExternalEconomiesContractorCode", Method: "GET", Path: "/v1/externalEconomies/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1ExternalEconomiesContractorCodeYearsYear", Method: "GET", Path: "/v1/externalEconomies/{contractorCode}/years/{year}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Fig", Method: "GET", Path: "/v1/fig", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1FigId", Method: "GET", Path: "/v1/fig/{id}", InfoType: APITypeAnalysis, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1FinancialIndicatorsContractorCode", Method: "GET", Path: "/v1/financialIndicators/{contractorCode}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1FinancialIndicatorsContractorCodeYearsYear", Method: "GET", Path: "/v1/financialIndicators/{contractorCode}/years/{year}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1FinancialScoringContractorCode", Method: "GET", Path: "/v1/financialScoring/{contractorCode}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1FinancialScoringContractorCodeYearsYear", Method: "GET", Path: "/v1/financialScoring/{contractorCode}/years/{year}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Generalprosecutor24febsuspect", Method: "GET", Path: "/v1/generalprosecutor24febsuspect", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1HistoryContractorCode", Method: "GET", Path: "/v1/history/{contractorCode}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsCec", Method: "GET", Path: "/v1/individualsCec", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsCecResultId", Method: "GET", Path: "/v1/individualsCec/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsCourtCasesToBeHeard", Method: "GET", Path: "/v1/individualsCourtCasesToBeHeard", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsCourtCasesToBeHeardResultId", Method: "GET", Path: "/v1/individualsCourtCasesToBeHeard/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsCourtStatusOfTheCase", Method: "GET", Path: "/v1/IndividualsCourtStatusOfTheCase", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsCourtStatusOfTheCaseResultId", Method: "GET", Path: "/v1/IndividualsCourtStatusOfTheCase/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsDsfmuTerrorists", Method: "GET", Path: "/v1/individualsDsfmuTerrorists", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsDsfmuTerroristsRecordTypes", Method: "GET", Path: "/v1/individualsDsfmuTerrorists/recordTypes", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsDsfmuTerroristsResultId", Method: "GET", Path: "/v1/individualsDsfmuTerrorists/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsFgvfoDebtors", Method: "GET", Path: "/v1/individualsFgvfoDebtors", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsFgvfoDebtorsResultId", Method: "GET", Path: "/v1/individualsFgvfoDebtors/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsFigCompanies", Method: "GET", Path: "/v1/individualsFigCompanies", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsFigCompaniesRelationTypes", Method: "GET", Path: "/v1/individualsFigCompanies/relationTypes", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsFigCompaniesResultId", Method: "GET", Path: "/v1/individualsFigCompanies/{resultId}", InfoType: APITypeAnalysis, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsFullNameInfo", Method: "GET", Path: "/v1/individualsFullNameInfo", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsFullNameInfoCoincidenceStatuses", Method: "GET", Path: "/v1/individualsFullNameInfo/coincidenceStatuses", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsFullNameInfoResultId", Method: "GET", Path: "/v1/individualsFullNameInfo/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsGlobalSanctionsLists", Method: "GET", Path: "/v1/individualsGlobalSanctionsLists", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsPdfReports", Method: "GET", Path: "/v1/individualsPdfReports", InfoType: APITypeCustom, Transaction: true, Key: KeyPDFIndividuals, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsPdfReportsResultId", Method: "GET", Path: "/v1/individualsPdfReports/{resultId}", InfoType: APITypeCustom, Transaction: false, Key: KeyPDFIndividuals, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsRelatedPersons", Method: "GET", Path: "/v1/individualsRelatedPersons", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsRelatedPersonsByCode", Method: "GET", Path: "/v1/individualsRelatedPersonsByCode", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsRelatedPersonsByCodeAssotiationTypes", Method: "GET", Path: "/v1/individualsRelatedPersonsByCode/assotiationTypes", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsRelatedPersonsByCodeContractorStatuses", Method: "GET", Path: "/v1/individualsRelatedPersonsByCode/contractorStatuses", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsRelatedPersonsByCodeResultId", Method: "GET", Path: "/v1/individualsRelatedPersonsByCode/{resultId}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsRelatedPersonsContractorStatuses", Method: "GET", Path: "/v1/individualsRelatedPersons/contractorStatuses", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsRelatedPersonsContractorTypes", Method: "GET", Path: "/v1/individualsRelatedPersons/contractorTypes", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsRelatedPersonsRelationStatuses", Method: "GET", Path: "/v1/individualsRelatedPersons/relationStatuses", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsRelatedPersonsRelationTypes", Method: "GET", Path: "/v1/individualsRelatedPersons/relationTypes", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsRelatedPersonsResultId", Method: "GET", Path: "/v1/individualsRelatedPersons/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsRnboSanctions", Method: "GET", Path: "/v1/individualsRnboSanctions", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsRnboSanctionsResultIdExtended", Method: "GET", Path: "/v1/individualsRnboSanctions/{resultId}/extended", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsSsuWantedAndTraitorPersons", Method: "GET", Path: "/v1/individualsSsuWantedAndTraitorPersons", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsSsuWantedAndTraitorPersonsGenders", Method: "GET", Path: "/v1/individualsSsuWantedAndTraitorPersons/genders", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsSsuWantedAndTraitorPersonsPhotosPhotoUrl", Method: "GET", Path: "/v1/individualsSsuWantedAndTraitorPersons/photos/{photoUrl}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1IndividualsSsuWantedAndTraitorPersonsResultId", Method: "GET", Path: "/v1/individualsSsuWantedAndTraitorPersons/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1IndividualsTaxDebtors", Method: "GET", Path: "/v1/individualsTaxDebtors", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1IndividualsTaxDebtorsResultId", Method: "GET", Path: "/v1/individualsTaxDebtors/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1InvestigationDetails", Method: "GET", Path: "/v1/investigationDetails", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1InvestigationsLegal", Method: "GET", Path: "/v1/investigationsLegal", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1InvestigationsNatural", Method: "GET", Path: "/v1/investigationsNatural", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Licenses", Method: "GET", Path: "/v1/licenses", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1LicensesLicenseCode", Method: "GET", Path: "/v1/licenses/{licenseCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1LicensesRegistersList", Method: "GET", Path: "/v1/licenses/registersList", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1LicensesRelevance", Method: "GET", Path: "/v1/licenses/relevance", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1LustratedPersons", Method: "GET", Path: "/v1/lustratedPersons", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1MarketScoringContractorCode", Method: "GET", Path: "/v1/marketScoring/{contractorCode}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1MarketScoringContractorCodeYearsYear", Method: "GET", Path: "/v1/marketScoring/{contractorCode}/years/{year}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Myrotvorets", Method: "GET", Path: "/v1/myrotvorets", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Nacpwarsanctions", Method: "GET", Path: "/v1/nacpwarsanctions", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1NonProfitCompaniesContractorCode", Method: "GET", Path: "/v1/nonProfitCompanies/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Passports", Method: "GET", Path: "/v1/passports", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Peps", Method: "GET", Path: "/v1/peps", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1PepsExtendedInfo", Method: "GET", Path: "/v1/peps/extendedInfo", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1PepsRelated", Method: "GET", Path: "/v1/peps/related", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Pepsforeign", Method: "GET", Path: "/v1/pepsforeign", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1PepsforeignRelated", Method: "GET", Path: "/v1/pepsforeign/related", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1RateLimits", Method: "GET", Path: "/v1/rateLimits", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: false},
+	{Name: "GetV1RealEstateContractorCode", Method: "GET", Path: "/v1/realEstate/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1RealEstateDataTypes", Method: "GET", Path: "/v1/realEstate/dataTypes", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1RealEstateDetailsLandId", Method: "GET", Path: "/v1/realEstate/details/{landId}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1RealEstateResultResultId", Method: "GET", Path: "/v1/realEstate/result/{resultId}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1RealEstateResultdetailsResultId", Method: "GET", Path: "/v1/realEstate/resultdetails/{resultId}", InfoType: APITypeAnalysis, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1Ruswarcriminals", Method: "GET", Path: "/v1/ruswarcriminals", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Sanctions", Method: "GET", Path: "/v1/sanctions", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1Secou", Method: "GET", Path: "/v1/secou", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1SetamAuctions", Method: "GET", Path: "/v1/setam/auctions", InfoType: APITypeCustom, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1SetamAuctionsProceedingsNumber", Method: "GET", Path: "/v1/setam/auctions/{proceedingsNumber}", InfoType: APITypeCustom, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1ShareholdersContractorCode", Method: "GET", Path: "/v1/shareholders/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1SingleTaxContractorCode", Method: "GET", Path: "/v1/singleTax/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1StaffContractorCode", Method: "GET", Path: "/v1/staff/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1TaxDebtContractorCode", Method: "GET", Path: "/v1/taxDebt/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1TendersContractStatuses", Method: "GET", Path: "/v1/tenders/contractStatuses", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1TendersContractsTenderId", Method: "GET", Path: "/v1/tenders/contracts/{tenderId}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1TendersProcedureTypes", Method: "GET", Path: "/v1/tenders/procedureTypes", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1TendersProcedures", Method: "GET", Path: "/v1/tenders/procedures", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1TendersRisksJournalId", Method: "GET", Path: "/v1/tenders/risks/{journalId}", InfoType: APITypeAnalysis, Transaction: false, Key: KeyDataAnalytics, Async: AsyncResult, Cacheable: true},
+	{Name: "GetV1TendersRisksStartTenderId", Method: "GET", Path: "/v1/tenders/risks/start/{tenderId}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncStart, Cacheable: false},
+	{Name: "GetV1TendersStatuses", Method: "GET", Path: "/v1/tenders/statuses", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1UsrAdministrativeServicesResultsCode", Method: "GET", Path: "/v1/usrAdministrativeServicesResults/{code}", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1UsrContractorCode", Method: "GET", Path: "/v1/usr/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1UsrDocumentsUsrOwnershipStructureFile", Method: "GET", Path: "/v1/usrDocuments/usrOwnershipStructureFile", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1UsrDocumentsUsrStatutFile", Method: "GET", Path: "/v1/usrDocuments/usrStatutFile", InfoType: APITypeAnalysis, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1VatCanceledContractorCode", Method: "GET", Path: "/v1/vatCanceled/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1VatContractorCode", Method: "GET", Path: "/v1/vat/{contractorCode}", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1VehiclesCheck", Method: "GET", Path: "/v1/vehicles/check", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1VehiclesOwned", Method: "GET", Path: "/v1/vehicles/owned", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1WantedOrDisappearedPersons", Method: "GET", Path: "/v1/wantedOrDisappearedPersons", InfoType: APITypeData, Transaction: true, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "GetV1WantedOrDisappearedPersonsPhotosId", Method: "GET", Path: "/v1/wantedOrDisappearedPersons/photos/{id}", InfoType: APITypeData, Transaction: false, Key: KeyDataAnalytics, Async: AsyncNone, Cacheable: true},
+	{Name: "PostV1AffiliatesQuery", Method: "POST", Path: "/v1/affiliates/query", InfoType: APITypeCustom, Transaction: true, Key: KeyAffiliates, Async: AsyncStart, Cacheable: false},
+}
@@ -0,0 +1,139 @@
+package youscore
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// APIKeyCategory identifies which of the APIKeys is used for an operation.
+type APIKeyCategory string
+
+const (
+	KeyDataAnalytics    APIKeyCategory = "dataAnalytics"
+	KeyPDFLegalEntities APIKeyCategory = "pdfLegalEntities"
+	KeyPDFIndividuals   APIKeyCategory = "pdfIndividuals"
+	KeyAffiliates       APIKeyCategory = "affiliates"
+)
+
+// AsyncRole describes the part an operation plays in the async "start, poll resultId" flow.
+type AsyncRole string
+
+const (
+	// AsyncNone operations answer with their data directly.
+	AsyncNone AsyncRole = ""
+	// AsyncStart operations start a request and answer with a resultId.
+	AsyncStart AsyncRole = "start"
+	// AsyncResult operations are polled with a resultId for the result of an AsyncStart operation.
+	AsyncResult AsyncRole = "result"
+)
+
+// Operation describes a single operation of the spec.
+// The registry of operations is generated from the swagger summaries into operations.gen.go.
+type Operation struct {
+	// Name is the operation name used by the generated client, e.g. GetV1UsrContractorCode.
+	Name string
+	// Method is the HTTP method.
+	Method string
+	// Path is the path template, e.g. /v1/usr/{contractorCode}.
+	Path string
+	// InfoType is the information type from the spec (DATA, ANALYTICS or CUSTOM).
+	InfoType APIType
+	// Transaction reports whether a call consumes a transaction (Transaction "+" in the spec).
+	Transaction bool
+	// Key is the API key category used to authorize the operation.
+	Key APIKeyCategory
+	// Async is the role of the operation in an async flow, if any.
+	Async AsyncRole
+	// Cacheable reports whether responses of the operation may be cached.
+	// Rate limits and the start of async jobs are never cached.
+	Cacheable bool
+}
+
+// Operations returns all operations of the spec, sorted by name.
+func Operations() []Operation {
+	return slices.Clone(operations)
+}
+
+// OperationByName returns the operation with the given name, e.g. GetV1UsrContractorCode.
+func OperationByName(name string) (Operation, bool) {
+	for _, op := range operations {
+		if op.Name == name {
+			return op, true
+		}
+	}
+	return Operation{}, false
+}
+
+// LookupOperation finds the operation matching the request method and path.
+// Literal path segments take precedence over path parameters,
+// so /v1/licenses/relevance matches its own operation rather than /v1/licenses/{licenseCode}.
+func LookupOperation(method, path string) (Operation, bool) {
+	// ignore any base path in front of the versioned API path
+	if i := strings.Index(path, "/v1/"); i > 0 {
+		path = path[i:]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var best Operation
+	bestScore := -1
+	for _, op := range operations {
+		if op.Method != method {
+			continue
+		}
+		score, ok := matchTemplate(op.Path, segments)
+		if ok && score > bestScore {
+			best = op
+			bestScore = score
+		}
+	}
+	return best, bestScore >= 0
+}
+
+// pathParams extracts the path parameters of the operation from a request path, by name.
+func (op Operation) pathParams(path string) map[string]string {
+	if i := strings.Index(path, "/v1/"); i > 0 {
+		path = path[i:]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	parts := strings.Split(strings.Trim(op.Path, "/"), "/")
+	if len(parts) != len(segments) {
+		return nil
+	}
+
+	params := make(map[string]string)
+	for i, part := range parts {
+		if name, ok := strings.CutPrefix(part, "{"); ok {
+			value, err := url.PathUnescape(segments[i])
+			if err != nil {
+				value = segments[i]
+			}
+			params[strings.TrimSuffix(name, "}")] = value
+		}
+	}
+	return params
+}
+
+// matchTemplate reports whether the path segments match the path template,
+// and how many segments matched literally.
+func matchTemplate(template string, segments []string) (int, bool) {
+	parts := strings.Split(strings.Trim(template, "/"), "/")
+	if len(parts) != len(segments) {
+		return 0, false
+	}
+
+	score := 0
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if !strings.EqualFold(part, segments[i]) {
+			return 0, false
+		}
+		score++
+	}
+	return score, true
+}
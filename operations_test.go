@@ -0,0 +1,140 @@
+package youscore
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+// keyCategoryByTag lists the spec tags of the operations that need a dedicated API key,
+// all other operations use the DataAnalytics key.
+var keyCategoryByTag = map[string]APIKeyCategory{
+	"PDF report of companies or sole proprietors check": KeyPDFLegalEntities,
+	"PDF report of private individual check":            KeyPDFIndividuals,
+	"Affiliates search":                                 KeyAffiliates,
+}
+
+// TestOperations_CoverSpec fails when a spec operation has no entry in the generated table,
+// or an entry whose key category does not match its spec tags. Run `just gen` after updating the spec.
+func TestOperations_CoverSpec(t *testing.T) {
+	data, err := os.ReadFile("spec/swagger_en_processed.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec struct {
+		Paths map[string]map[string]struct {
+			Tags []string `json:"tags"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	known := make(map[string]Operation)
+	for _, op := range operations {
+		known[op.Method+" "+op.Path] = op
+	}
+
+	for path, methods := range spec.Paths {
+		for method, specOp := range methods {
+			id := strings.ToUpper(method) + " " + path
+			op, ok := known[id]
+			if !ok {
+				t.Errorf("%s: missing from operations table", id)
+				continue
+			}
+			want := KeyDataAnalytics
+			for _, tag := range specOp.Tags {
+				if key, ok := keyCategoryByTag[tag]; ok {
+					want = key
+				}
+			}
+			if op.Key != want {
+				t.Errorf("%s: key category %s, want %s", id, op.Key, want)
+			}
+		}
+	}
+	if len(operations) != len(known) {
+		t.Errorf("operations table has %d duplicate entries", len(operations)-len(known))
+	}
+}
+
+func TestLookupOperation(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{"GET", "/v1/usr/08215600", "GetV1UsrContractorCode"},
+		{"GET", "/v1/licenses/relevance", "GetV1LicensesRelevance"},
+		{"GET", "/v1/licenses/LIC-1", "GetV1LicensesLicenseCode"},
+		{"GET", "/v1/individualsFullNameInfo/coincidenceStatuses", "GetV1IndividualsFullNameInfoCoincidenceStatuses"},
+		{"GET", "/v1/individualsFullNameInfo/abc", "GetV1IndividualsFullNameInfoResultId"},
+		{"GET", "/v1/marketScoring/08215600/years/2023", "GetV1MarketScoringContractorCodeYearsYear"},
+		{"POST", "/v1/affiliates/query", "PostV1AffiliatesQuery"},
+		{"GET", "/base/v1/usr/08215600", "GetV1UsrContractorCode"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			op, ok := LookupOperation(tt.method, tt.path)
+			if !ok {
+				t.Fatalf("no operation found")
+			}
+			if op.Name != tt.want {
+				t.Errorf("got %s, want %s", op.Name, tt.want)
+			}
+		})
+	}
+
+	if _, ok := LookupOperation("DELETE", "/v1/usr/08215600"); ok {
+		t.Error("expected no operation for unknown method")
+	}
+}
+
+func TestOperations_Metadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		infoType    APIType
+		transaction bool
+		key         APIKeyCategory
+		async       AsyncRole
+		cacheable   bool
+	}{
+		{"GetV1UsrContractorCode", APITypeData, true, KeyDataAnalytics, AsyncNone, true},
+		{"GetV1HistoryContractorCode", APITypeAnalysis, true, KeyDataAnalytics, AsyncNone, true},
+		{"GetV1ContractorsPdfFileContractorCode", APITypeCustom, true, KeyPDFLegalEntities, AsyncNone, true},
+		{"GetV1IndividualsPdfReports", APITypeCustom, true, KeyPDFIndividuals, AsyncStart, false},
+		{"GetV1IndividualsPdfReportsResultId", APITypeCustom, false, KeyPDFIndividuals, AsyncResult, true},
+		{"PostV1AffiliatesQuery", APITypeCustom, true, KeyAffiliates, AsyncStart, false},
+		{"GetV1AffiliatesResultId", APITypeCustom, false, KeyAffiliates, AsyncResult, true},
+		{"GetV1TendersRisksStartTenderId", APITypeAnalysis, true, KeyDataAnalytics, AsyncStart, false},
+		{"GetV1TendersRisksJournalId", APITypeAnalysis, false, KeyDataAnalytics, AsyncResult, true},
+		{"GetV1FinancialIndicatorsContractorCode", APITypeData, false, KeyDataAnalytics, AsyncNone, true},
+		{"GetV1LicensesRelevance", APITypeData, false, KeyDataAnalytics, AsyncNone, true},
+		{"GetV1RateLimits", APITypeData, false, KeyDataAnalytics, AsyncNone, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, ok := OperationByName(tt.name)
+			if !ok {
+				t.Fatal("operation not found")
+			}
+			if op.InfoType != tt.infoType {
+				t.Errorf("InfoType = %q, want %q", op.InfoType, tt.infoType)
+			}
+			if op.Transaction != tt.transaction {
+				t.Errorf("Transaction = %t, want %t", op.Transaction, tt.transaction)
+			}
+			if op.Key != tt.key {
+				t.Errorf("Key = %q, want %q", op.Key, tt.key)
+			}
+			if op.Async != tt.async {
+				t.Errorf("Async = %q, want %q", op.Async, tt.async)
+			}
+			if op.Cacheable != tt.cacheable {
+				t.Errorf("Cacheable = %t, want %t", op.Cacheable, tt.cacheable)
+			}
+		})
+	}
+}
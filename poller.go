@@ -0,0 +1,613 @@
+package youscore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+)
+
+// ErrResultNotFound is returned by the Poller when the result endpoint answers 404,
+// e.g. because the resultId expired or never existed. It matches ErrNotFound.
+var ErrResultNotFound = fmt.Errorf("youscore: result %w", ErrNotFound)
+
+// PollerOption configures a Poller.
+type PollerOption func(*Poller)
+
+// WithPollBackoff sets the delay before the first poll and the maximum delay between polls.
+// The delay is multiplied by factor after every 202 "Update in progress" response.
+// A delay that is not positive or a factor not above 1 keeps the default of NewPoller,
+// a maximum below the initial delay is raised to it. For a constant delay, pass the same initial and maximum delay.
+func WithPollBackoff(initialDelay, maxDelay time.Duration, factor float64) PollerOption {
+	return func(p *Poller) {
+		if initialDelay > 0 {
+			p.initialDelay = initialDelay
+		}
+		if maxDelay > 0 {
+			p.maxDelay = maxDelay
+		}
+		if factor > 1 {
+			p.factor = factor
+		}
+		p.maxDelay = max(p.maxDelay, p.initialDelay)
+	}
+}
+
+// WithPollTimeout sets the overall deadline for starting a request and polling its result.
+// A zero timeout means only the ctx deadline applies. A result that is still in progress
+// when the deadline expires fails with an error matching ErrInProgress.
+func WithPollTimeout(timeout time.Duration) PollerOption {
+	return func(p *Poller) {
+		p.timeout = timeout
+	}
+}
+
+// Poller runs the "start, extract resultId, poll until 200/404" loop for the async endpoints.
+// Each method starts the request, then polls the matching result endpoint
+// until it returns a final status, the overall deadline passes, or ctx is cancelled.
+type Poller struct {
+	client       ClientWithResponsesInterface
+	initialDelay time.Duration
+	maxDelay     time.Duration
+	factor       float64
+	timeout      time.Duration
+}
+
+// NewPoller creates a Poller using the given client.
+// By default it waits 1s before the first poll, backs off by a factor of 2 up to 15s,
+// and gives up after 5 minutes.
+func NewPoller(client ClientWithResponsesInterface, opts ...PollerOption) *Poller {
+	p := &Poller{
+		client:       client,
+		initialDelay: time.Second,
+		maxDelay:     15 * time.Second,
+		factor:       2,
+		timeout:      5 * time.Minute,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+// AffiliatesQuery starts an affiliates search and polls for its result.
+func (p *Poller) AffiliatesQuery(ctx context.Context, body PostV1AffiliatesQueryJSONRequestBody) (*[]YCApiModelsAffiliatesAffiliateRoot, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.PostV1AffiliatesQueryWithResponse(ctx, body)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *[]YCApiModelsAffiliatesAffiliateRoot, error) {
+			res, err := p.client.GetV1AffiliatesResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsFigCompanies starts a private individual corporate group affiliation check and polls for its result.
+func (p *Poller) IndividualsFigCompanies(ctx context.Context, params *GetV1IndividualsFigCompaniesParams) (*YCApiModelsResponseIndividualsFigCompaniesSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsFigCompaniesWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsFigCompaniesSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsFigCompaniesResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsRnboSanctions starts an RNBO sanctions check and polls for its extended result.
+func (p *Poller) IndividualsRnboSanctions(ctx context.Context, params *GetV1IndividualsRnboSanctionsParams) (*YCApiModelsResponseRnboSanctionsIndividualsRnboSanctionsSubjectsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsRnboSanctionsWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseRnboSanctionsIndividualsRnboSanctionsSubjectsModel, error) {
+			res, err := p.client.GetV1IndividualsRnboSanctionsResultIdExtendedWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsCourtCasesToBeHeard starts a court cases scheduled for hearing check and polls for its result.
+func (p *Poller) IndividualsCourtCasesToBeHeard(ctx context.Context, params *GetV1IndividualsCourtCasesToBeHeardParams) (*YCApiModelsResponseCourtsIndividualsCourtCasesToBeHeardSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsCourtCasesToBeHeardWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseCourtsIndividualsCourtCasesToBeHeardSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsCourtCasesToBeHeardResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsCourtStatusOfTheCase starts a court case status check and polls for its result.
+func (p *Poller) IndividualsCourtStatusOfTheCase(ctx context.Context, params *GetV1IndividualsCourtStatusOfTheCaseParams) (*YCApiModelsResponseCourtsIndividualsCourtStatusOfTheCaseSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsCourtStatusOfTheCaseWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseCourtsIndividualsCourtStatusOfTheCaseSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsCourtStatusOfTheCaseResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// EnforcementIndividual starts a private individual enforcement proceedings check and polls for its result.
+// resultParams controls paging of the result and may be nil.
+func (p *Poller) EnforcementIndividual(ctx context.Context, params *GetV1EnforcementIndividualParams, resultParams *GetV1EnforcementIndividualResultIdParams) (*YCApiModelsCommonPagedResult1YCApiModelsResponseEnforcementsEnforcementIndividualInfo, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1EnforcementIndividualWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsCommonPagedResult1YCApiModelsResponseEnforcementsEnforcementIndividualInfo, error) {
+			res, err := p.client.GetV1EnforcementIndividualResultIdWithResponse(ctx, id, resultParams)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsPdfReports starts a private individual PDF report and polls for its result.
+func (p *Poller) IndividualsPdfReports(ctx context.Context, params *GetV1IndividualsPdfReportsParams) (*YCApiModelsResponseIndividualsPdfReportsResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsPdfReportsWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsPdfReportsResultsModel, error) {
+			res, err := p.client.GetV1IndividualsPdfReportsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// Encumbrances starts a counterparty encumbered property check and polls for its result.
+func (p *Poller) Encumbrances(ctx context.Context, contractorCode string) (*[]YCApiModelsResponseEncumbrancesMovableEncumbranceDetailsApiResponse, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1EncumbrancesContractorCodeWithResponse(ctx, contractorCode)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *[]YCApiModelsResponseEncumbrancesMovableEncumbranceDetailsApiResponse, error) {
+			res, err := p.client.GetV1EncumbrancesResultResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// EncumbranceDetails starts an encumbered object details request and polls for its result.
+// The spec does not document the result schema, so the raw JSON body is returned.
+func (p *Poller) EncumbranceDetails(ctx context.Context, encumbranceID string) (*json.RawMessage, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1EncumbrancesDetailsEncumbranceIdWithResponse(ctx, encumbranceID)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *json.RawMessage, error) {
+			res, err := p.client.GetV1EncumbrancesResultdetailsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), rawBody(res.Body), nil
+		},
+	)
+}
+
+// RealEstate starts a counterparty real estate check and polls for its result.
+func (p *Poller) RealEstate(ctx context.Context, contractorCode string) (*[]YCApiModelsResponseRealEstateRealEstateDescriptor, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1RealEstateContractorCodeWithResponse(ctx, contractorCode)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *[]YCApiModelsResponseRealEstateRealEstateDescriptor, error) {
+			res, err := p.client.GetV1RealEstateResultResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// RealEstateDetails starts a real estate object details request and polls for its result.
+// The spec does not document the result schema, so the raw JSON body is returned.
+func (p *Poller) RealEstateDetails(ctx context.Context, landID string) (*json.RawMessage, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1RealEstateDetailsLandIdWithResponse(ctx, landID)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *json.RawMessage, error) {
+			res, err := p.client.GetV1RealEstateResultdetailsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), rawBody(res.Body), nil
+		},
+	)
+}
+
+// IndividualsRelatedPersons starts a related companies check for an individual and polls for its result.
+func (p *Poller) IndividualsRelatedPersons(ctx context.Context, params *GetV1IndividualsRelatedPersonsParams) (*YCApiModelsResponseIndividualsRelatedPersonsSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsRelatedPersonsWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsRelatedPersonsSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsRelatedPersonsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsRelatedPersonsByCode starts a related companies check by TIN or passport and polls for its result.
+func (p *Poller) IndividualsRelatedPersonsByCode(ctx context.Context, params *GetV1IndividualsRelatedPersonsByCodeParams) (*YCApiModelsResponseIndividualsRelatedPersonsSearchByCodeResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsRelatedPersonsByCodeWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsRelatedPersonsSearchByCodeResultsModel, error) {
+			res, err := p.client.GetV1IndividualsRelatedPersonsByCodeResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsFullNameInfo starts a full name uniqueness check and polls for its result.
+func (p *Poller) IndividualsFullNameInfo(ctx context.Context, params *GetV1IndividualsFullNameInfoParams) (*YCApiModelsResponseIndividualsFullNameInfoCheckResult, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsFullNameInfoWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsFullNameInfoCheckResult, error) {
+			res, err := p.client.GetV1IndividualsFullNameInfoResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsSsuWantedAndTraitorPersons starts an SSU wanted or traitor persons check and polls for its result.
+func (p *Poller) IndividualsSsuWantedAndTraitorPersons(ctx context.Context, params *GetV1IndividualsSsuWantedAndTraitorPersonsParams) (*YCApiModelsResponseIndividualsSsuWantedAndTraitorPersonsSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsSsuWantedAndTraitorPersonsWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsSsuWantedAndTraitorPersonsSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsSsuWantedAndTraitorPersonsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// CorruptedPersons starts a corrupted persons registry check and polls for its result.
+func (p *Poller) CorruptedPersons(ctx context.Context, params *GetV1CorruptedPersonsParams) (*YCApiModelsResponseNaturalPersonsCorruptedPersonsSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1CorruptedPersonsWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseNaturalPersonsCorruptedPersonsSearchResultsModel, error) {
+			res, err := p.client.GetV1CorruptedPersonsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsDsfmuTerrorists starts a DSFMU terrorists check and polls for its result.
+func (p *Poller) IndividualsDsfmuTerrorists(ctx context.Context, params *GetV1IndividualsDsfmuTerroristsParams) (*YCApiModelsResponseIndividualsDsfmuTerroristsSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsDsfmuTerroristsWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsDsfmuTerroristsSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsDsfmuTerroristsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsFgvfoDebtors starts a Deposit Guarantee Fund debtors check and polls for its result.
+func (p *Poller) IndividualsFgvfoDebtors(ctx context.Context, params *GetV1IndividualsFgvfoDebtorsParams) (*YCApiModelsResponseIndividualsFgvfoDebtorsSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsFgvfoDebtorsWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsFgvfoDebtorsSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsFgvfoDebtorsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsTaxDebtors starts a private individual tax debtors check and polls for its result.
+func (p *Poller) IndividualsTaxDebtors(ctx context.Context, params *GetV1IndividualsTaxDebtorsParams) (*YCApiModelsResponseIndividualsTaxDebtorsSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsTaxDebtorsWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsTaxDebtorsSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsTaxDebtorsResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// IndividualsCec starts an election participation check and polls for its result.
+func (p *Poller) IndividualsCec(ctx context.Context, params *GetV1IndividualsCecParams) (*YCApiModelsResponseIndividualsCecSearchResultsModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1IndividualsCecWithResponse(ctx, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseIndividualsCecSearchResultsModel, error) {
+			res, err := p.client.GetV1IndividualsCecResultIdWithResponse(ctx, id)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// TendersRisks starts a tender participants check and polls the journal for its result.
+// Unlike the other start endpoints, the start call answers 200 with a journalId.
+func (p *Poller) TendersRisks(ctx context.Context, tenderID string, resultParams *GetV1TendersRisksJournalIdParams) (*YCApiModelsResponseTendersCheckTenderJournalRecordInfoFullModel, error) {
+	return poll(ctx, p,
+		func(ctx context.Context) (int, []byte, error) {
+			res, err := p.client.GetV1TendersRisksStartTenderIdWithResponse(ctx, tenderID)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.Body, nil
+		},
+		func(ctx context.Context, id string) (int, *YCApiModelsResponseTendersCheckTenderJournalRecordInfoFullModel, error) {
+			res, err := p.client.GetV1TendersRisksJournalIdWithResponse(ctx, id, resultParams)
+			if err != nil {
+				return 0, nil, err
+			}
+			return res.StatusCode(), res.JSON200, nil
+		},
+	)
+}
+
+// poll starts an async request, extracts the resultId from its response and polls
+// the result endpoint until it answers 200 or 404.
+func poll[T any](
+	ctx context.Context,
+	p *Poller,
+	start func(ctx context.Context) (status int, body []byte, err error),
+	result func(ctx context.Context, resultID string) (status int, value *T, err error),
+) (*T, error) {
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	status, body, err := start(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start: %w", err)
+	}
+	if status != http.StatusAccepted && status != http.StatusOK {
+		return nil, fmt.Errorf("start: %w", &APIError{StatusCode: status, Body: body})
+	}
+	resultID, err := parseResultID(body)
+	if err != nil {
+		return nil, fmt.Errorf("start: %w", err)
+	}
+
+	status, value, err := awaitResult(ctx, p, true, func(ctx context.Context) (int, *T, error) {
+		return result(ctx, resultID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("poll result %s: %w", resultID, err)
+	}
+	switch status {
+	case http.StatusOK:
+		return value, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("poll result %s: %w", resultID, ErrResultNotFound)
+	}
+	return nil, fmt.Errorf("poll result %s: %w", resultID, &APIError{StatusCode: status})
+}
+
+// awaitResult calls fetch with the backoff of the Poller for as long as it answers 202,
+// and returns its last response. If wait is set, the first call is made after the initial delay.
+// When ctx is done while the result is still in progress, the error matches ErrInProgress and the error of ctx.
+func awaitResult[T any](
+	ctx context.Context,
+	p *Poller,
+	wait bool,
+	fetch func(ctx context.Context) (status int, value *T, err error),
+) (int, *T, error) {
+	delay := p.initialDelay
+	for {
+		if wait {
+			if err := sleepCtx(ctx, delay); err != nil {
+				return http.StatusAccepted, nil, fmt.Errorf("%w: %w", ErrInProgress, err)
+			}
+			delay = min(time.Duration(float64(delay)*p.factor), p.maxDelay)
+		}
+		wait = true
+
+		status, value, err := fetch(ctx)
+		if status != http.StatusAccepted {
+			return status, value, err
+		}
+	}
+}
+
+// parseResultID extracts the identifier of the pending result from a start response body.
+// YouScore answers with {"resultId": "...", "resultUrl": "..."} for most endpoints,
+// {"id": "..."} for affiliates and {"journalId": "..."} for tender risks.
+func parseResultID(body []byte) (string, error) {
+	var v struct {
+		ResultID  string `json:"resultId"`
+		ID        string `json:"id"`
+		JournalID string `json:"journalId"`
+		ResultURL string `json:"resultUrl"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "", fmt.Errorf("unmarshal result id: %w", err)
+	}
+
+	switch {
+	case v.ResultID != "":
+		return v.ResultID, nil
+	case v.ID != "":
+		return v.ID, nil
+	case v.JournalID != "":
+		return v.JournalID, nil
+	case v.ResultURL != "":
+		return path.Base(v.ResultURL), nil
+	}
+	return "", errors.New("no result id in response")
+}
+
+// rawBody returns the body as a json.RawMessage, or nil if it is empty.
+func rawBody(body []byte) *json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	raw := json.RawMessage(body)
+	return &raw
+}
+
+// sleepCtx waits for d or until ctx is done.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
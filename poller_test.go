@@ -0,0 +1,182 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// scriptedResponse is a canned response for a scriptedDoer.
+type scriptedResponse struct {
+	status int
+	body   string
+	header http.Header
+}
+
+// scriptedDoer returns the scripted responses for each path in order,
+// repeating the last one once the script is exhausted.
+type scriptedDoer struct {
+	mu     sync.Mutex
+	script map[string][]scriptedResponse
+	calls  map[string]int
+}
+
+func newScriptedDoer(script map[string][]scriptedResponse) *scriptedDoer {
+	return &scriptedDoer{script: script, calls: make(map[string]int)}
+}
+
+func (d *scriptedDoer) Do(req *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	p := req.URL.Path
+	responses := d.script[p]
+	if len(responses) == 0 {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	}
+	i := d.calls[p]
+	if i >= len(responses) {
+		i = len(responses) - 1
+	}
+	d.calls[p]++
+
+	r := responses[i]
+	header := r.header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Type", "application/json")
+	return &http.Response{
+		StatusCode: r.status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(r.body)),
+		Request:    req,
+	}, nil
+}
+
+func (d *scriptedDoer) count(path string) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calls[path]
+}
+
+func TestPoller_PollsUntilOK(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/individualsFullNameInfo": {{status: http.StatusAccepted, body: `{"resultId":"abc","resultUrl":"/v1/individualsFullNameInfo/abc"}`}},
+		"/v1/individualsFullNameInfo/abc": {
+			{status: http.StatusAccepted},
+			{status: http.StatusAccepted},
+			{status: http.StatusOK, body: `{"value":0.2}`},
+		},
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl, WithPollBackoff(time.Millisecond, 5*time.Millisecond, 2))
+	res, err := p.IndividualsFullNameInfo(t.Context(), &GetV1IndividualsFullNameInfoParams{LastName: "Шевченко", FirstName: "Тарас"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil || res.Value == nil || *res.Value != 0.2 {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	if got := doer.count("/v1/individualsFullNameInfo/abc"); got != 3 {
+		t.Fatalf("expected 3 polls, got %d", got)
+	}
+}
+
+func TestPoller_NotFound(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/affiliates/query":     {{status: http.StatusAccepted, body: `{"id":"42"}`}},
+		"/v1/affiliates/result/42": {{status: http.StatusNotFound}},
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl, WithPollBackoff(time.Millisecond, time.Millisecond, 2))
+	_, err = p.AffiliatesQuery(t.Context(), PostV1AffiliatesQueryJSONRequestBody{})
+	if !errors.Is(err, ErrResultNotFound) {
+		t.Fatalf("expected ErrResultNotFound, got %v", err)
+	}
+}
+
+func TestPoller_Timeout(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/corruptedPersons":      {{status: http.StatusAccepted, body: `{"resultId":"slow"}`}},
+		"/v1/corruptedPersons/slow": {{status: http.StatusAccepted}},
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl,
+		WithPollBackoff(time.Millisecond, 2*time.Millisecond, 2),
+		WithPollTimeout(20*time.Millisecond),
+	)
+	_, err = p.CorruptedPersons(t.Context(), &GetV1CorruptedPersonsParams{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestWithPollBackoff(t *testing.T) {
+	tests := []struct {
+		name         string
+		initial, max time.Duration
+		factor       float64
+		wantInitial  time.Duration
+		wantMax      time.Duration
+		wantFactor   float64
+	}{
+		{"valid", 2 * time.Second, 30 * time.Second, 1.5, 2 * time.Second, 30 * time.Second, 1.5},
+		{"zero", 0, 0, 0, time.Second, 15 * time.Second, 2},
+		{"negative", -time.Second, -time.Second, -1, time.Second, 15 * time.Second, 2},
+		{"factor 1", time.Second, time.Second, 1, time.Second, time.Second, 2},
+		{"max below initial", 20 * time.Second, 5 * time.Second, 2, 20 * time.Second, 20 * time.Second, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPoller(nil, WithPollBackoff(tt.initial, tt.max, tt.factor))
+			if p.initialDelay != tt.wantInitial || p.maxDelay != tt.wantMax || p.factor != tt.wantFactor {
+				t.Errorf("got %v, %v, %v, want %v, %v, %v", p.initialDelay, p.maxDelay, p.factor, tt.wantInitial, tt.wantMax, tt.wantFactor)
+			}
+		})
+	}
+}
+
+func TestParseResultID(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"resultId":"abc"}`, "abc"},
+		{`{"id":"42"}`, "42"},
+		{`{"journalId":"65e7"}`, "65e7"},
+		{`{"resultUrl":"https://api.youscore.com.ua/v1/corruptedPersons/xyz"}`, "xyz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.body, func(t *testing.T) {
+			got, err := parseResultID([]byte(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("parseResultID(%s) = %q, want %q", tt.body, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := parseResultID([]byte(`{}`)); err == nil {
+		t.Error("expected error for body without result id")
+	}
+}
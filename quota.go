@@ -0,0 +1,230 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrQuotaExhausted is returned by a QuotaGuard when a billable request would
+// use up the reserve of the API key quota.
+var ErrQuotaExhausted = errors.New("youscore: quota exhausted")
+
+// QuotaGuardOption configures a QuotaGuard.
+type QuotaGuardOption func(*QuotaGuard)
+
+// WithQuotaReserve sets how many requests of each key quota are kept in reserve.
+// Billable requests are refused once RequestsLeft is at or below the reserve.
+func WithQuotaReserve(reserve int) QuotaGuardOption {
+	return func(g *QuotaGuard) {
+		g.reserve = reserve
+	}
+}
+
+// WithQuotaRefreshInterval sets how often Run refreshes the quotas.
+// Intervals of zero or less keep the default of 5 minutes.
+func WithQuotaRefreshInterval(interval time.Duration) QuotaGuardOption {
+	return func(g *QuotaGuard) {
+		g.interval = interval
+	}
+}
+
+// QuotaGuard keeps a snapshot of the remaining quota of every (non-blank) API key,
+// and refuses billable requests locally once a quota runs low.
+//
+// Attach it to a client with WithQuotaGuard and run the refresh loop in the background:
+//
+//	guard := youscore.NewQuotaGuard(keys, youscore.WithQuotaReserve(100))
+//	cl, err := youscore.NewClientWithResponses(youscore.ServerURL,
+//		youscore.WithAPIKeys(keys),
+//		youscore.WithQuotaGuard(guard),
+//	)
+//	go guard.Run(ctx)
+type QuotaGuard struct {
+	keys     APIKeys
+	reserve  int
+	interval time.Duration
+
+	mu       sync.RWMutex
+	client   *Client         // the client the guard is attached to, its Server is read on every Refresh
+	doer     HttpRequestDoer // the HTTP client below the guard
+	snapshot map[APIKeyCategory]*RateLimits
+}
+
+// NewQuotaGuard creates a QuotaGuard for the given keys.
+// By default it keeps no reserve and refreshes every 5 minutes.
+func NewQuotaGuard(keys APIKeys, opts ...QuotaGuardOption) *QuotaGuard {
+	g := &QuotaGuard{
+		keys:     keys,
+		interval: defaultQuotaRefreshInterval,
+		snapshot: make(map[APIKeyCategory]*RateLimits),
+	}
+	for _, o := range opts {
+		o(g)
+	}
+	if g.interval <= 0 {
+		g.interval = defaultQuotaRefreshInterval
+	}
+	return g
+}
+
+const defaultQuotaRefreshInterval = 5 * time.Minute
+
+// WithQuotaGuard returns a ClientOption that wraps the underlying HTTP client and
+// refuses billable requests with ErrQuotaExhausted once the quota of their key is
+// at or below the reserve. Quotas are fetched through the HTTP client below the guard,
+// from the server of the client at the time of the refresh.
+//
+// A QuotaGuard tracks the quotas of a single client: attaching it to a second client fails.
+// Clients sharing keys should share the client instead.
+//
+// Until the first refresh, requests are never refused.
+// Apply this option before WithCache, so that cache hits do not count against the quota.
+func WithQuotaGuard(g *QuotaGuard) ClientOption {
+	return func(c *Client) error {
+		inner := c.Client
+		if inner == nil {
+			inner = &http.Client{}
+		}
+
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if g.client != nil && g.client != c {
+			return errors.New("youscore: QuotaGuard is already attached to another client")
+		}
+		g.client = c
+		g.doer = inner
+
+		c.Client = &quotaDoer{
+			inner: inner,
+			guard: g,
+		}
+		return nil
+	}
+}
+
+// Run refreshes the quotas immediately and then periodically, until ctx is done.
+// Refresh errors do not stop the loop, the previous snapshot stays in place.
+func (g *QuotaGuard) Run(ctx context.Context) error {
+	t := time.NewTicker(g.interval)
+	defer t.Stop()
+	for {
+		_ = g.Refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Refresh fetches the current quota of every non-blank key.
+// Keys that fail to refresh keep their previous snapshot.
+func (g *QuotaGuard) Refresh(ctx context.Context) error {
+	g.mu.RLock()
+	server, doer := ServerURL, g.doer
+	if g.client != nil {
+		server = g.client.Server
+	}
+	g.mu.RUnlock()
+
+	var opts []ClientOption
+	if doer != nil {
+		opts = append(opts, WithHTTPClient(doer))
+	}
+	res, err := checkRateLimits(ctx, server, g.keys, opts...)
+	if res == nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for category, limits := range map[APIKeyCategory]*RateLimits{
+		KeyDataAnalytics:    res.DataAnalytics,
+		KeyPDFLegalEntities: res.PDFLegalEntities,
+		KeyPDFIndividuals:   res.PDFIndividuals,
+		KeyAffiliates:       res.Affiliates,
+	} {
+		if limits != nil {
+			g.snapshot[category] = limits
+		}
+	}
+	return err
+}
+
+// Snapshot returns the most recently known quotas, without calling the API.
+// Keys that have not been refreshed yet are nil.
+func (g *QuotaGuard) Snapshot() RateLimitsResponse {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	clone := func(l *RateLimits) *RateLimits {
+		if l == nil {
+			return nil
+		}
+		c := *l
+		return &c
+	}
+	return RateLimitsResponse{
+		DataAnalytics:    clone(g.snapshot[KeyDataAnalytics]),
+		PDFLegalEntities: clone(g.snapshot[KeyPDFLegalEntities]),
+		PDFIndividuals:   clone(g.snapshot[KeyPDFIndividuals]),
+		Affiliates:       clone(g.snapshot[KeyAffiliates]),
+	}
+}
+
+// take counts a billable request against the local snapshot, until the next refresh,
+// or returns ErrQuotaExhausted if the quota of the category is at or below the reserve.
+// The returned snapshot is passed to refund if the request turns out not to be billed.
+func (g *QuotaGuard) take(category APIKeyCategory) (*RateLimits, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	limits := g.snapshot[category]
+	if limits == nil {
+		return nil, nil
+	}
+	if limits.RequestsLeft <= g.reserve {
+		return nil, fmt.Errorf("%w: %s key has %d requests left", ErrQuotaExhausted, category, limits.RequestsLeft)
+	}
+	limits.RequestsLeft--
+	return limits, nil
+}
+
+// refund gives back a request reserved from limits, unless the snapshot has been refreshed since.
+func (g *QuotaGuard) refund(category APIKeyCategory, limits *RateLimits) {
+	if limits == nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.snapshot[category] == limits {
+		limits.RequestsLeft++
+	}
+}
+
+type quotaDoer struct {
+	inner HttpRequestDoer
+	guard *QuotaGuard
+}
+
+func (d *quotaDoer) Do(req *http.Request) (*http.Response, error) {
+	op, ok := LookupOperation(req.Method, req.URL.EscapedPath())
+	if !ok || !op.Transaction {
+		return d.inner.Do(req)
+	}
+
+	reserved, err := d.guard.take(op.Key)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.inner.Do(req)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		d.guard.refund(op.Key, reserved)
+	}
+	return resp, err
+}
@@ -0,0 +1,164 @@
+package youscore
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestQuotaGuard(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/rateLimits":            {{status: http.StatusOK, body: `{"requestsLeft":3,"totalLimits":100}`}},
+		"/v1/usr/08215600":          {{status: http.StatusOK, body: `{}`}},
+		"/v1/corruptedPersons/abc":  {{status: http.StatusOK, body: `{}`}},
+		"/v1/contractorsPdf/file/1": {{status: http.StatusOK, body: `{}`}},
+	})
+	keys := APIKeys{DataAnalytics: "da-key"}
+	guard := NewQuotaGuard(keys, WithQuotaReserve(2))
+
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithAPIKeys(keys),
+		WithQuotaGuard(guard),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := t.Context()
+
+	// before the first refresh nothing is refused
+	if snap := guard.Snapshot(); snap.DataAnalytics != nil {
+		t.Fatalf("expected empty snapshot, got %+v", snap.DataAnalytics)
+	}
+
+	if err := guard.Refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if snap := guard.Snapshot(); snap.DataAnalytics == nil || snap.DataAnalytics.RequestsLeft != 3 {
+		t.Fatalf("unexpected snapshot: %+v", snap.DataAnalytics)
+	}
+
+	// 3 left, reserve 2: one billable request passes
+	if _, err := cl.GetV1UsrContractorCode(ctx, "08215600", nil); err != nil {
+		t.Fatal(err)
+	}
+	_, err = cl.GetV1UsrContractorCode(ctx, "08215600", nil)
+	if !errors.Is(err, ErrQuotaExhausted) {
+		t.Fatalf("expected ErrQuotaExhausted, got %v", err)
+	}
+
+	// polling results is free
+	if _, err := cl.GetV1CorruptedPersonsResultId(ctx, "abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	// other keys are not affected
+	if _, err := cl.GetV1ContractorsPdfFileContractorCode(ctx, "1", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := doer.count("/v1/usr/08215600"); got != 1 {
+		t.Fatalf("expected 1 call, got %d", got)
+	}
+}
+
+func TestQuotaGuard_ConcurrentReserve(t *testing.T) {
+	guard := NewQuotaGuard(APIKeys{DataAnalytics: "da-key"}, WithQuotaReserve(2))
+	guard.snapshot[KeyDataAnalytics] = &RateLimits{RequestsLeft: 5}
+	doer := &blockingDoer{release: make(chan struct{})}
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer), WithQuotaGuard(guard))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 10
+	var (
+		wg      sync.WaitGroup
+		refused atomic.Int32
+	)
+	for range n {
+		wg.Go(func() {
+			_, err := cl.GetV1UsrContractorCode(t.Context(), "08215600", nil)
+			if errors.Is(err, ErrQuotaExhausted) {
+				refused.Add(1)
+			}
+		})
+	}
+	for doer.calls.Load()+refused.Load() < n {
+		time.Sleep(time.Millisecond)
+	}
+	close(doer.release)
+	wg.Wait()
+
+	if got := doer.calls.Load(); got != 3 {
+		t.Fatalf("expected 3 requests above the reserve, got %d", got)
+	}
+	if left := guard.Snapshot().DataAnalytics.RequestsLeft; left != 2 {
+		t.Fatalf("expected 2 requests left, got %d", left)
+	}
+}
+
+func TestQuotaGuard_RefundsUnbilled(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {{status: http.StatusInternalServerError}},
+	})
+	guard := NewQuotaGuard(APIKeys{DataAnalytics: "da-key"}, WithQuotaRefreshInterval(0))
+	guard.snapshot[KeyDataAnalytics] = &RateLimits{RequestsLeft: 5}
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer), WithQuotaGuard(guard))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cl.GetV1UsrContractorCode(t.Context(), "08215600", nil); err != nil {
+		t.Fatal(err)
+	}
+	if left := guard.Snapshot().DataAnalytics.RequestsLeft; left != 5 {
+		t.Fatalf("expected the failed request to be refunded, got %d left", left)
+	}
+	if guard.interval != defaultQuotaRefreshInterval {
+		t.Fatalf("expected the default interval, got %s", guard.interval)
+	}
+}
+
+// hostRecordingDoer wraps a doer and records the hosts of the requests it receives.
+type hostRecordingDoer struct {
+	inner HttpRequestDoer
+	hosts []string
+}
+
+func (d *hostRecordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.hosts = append(d.hosts, req.URL.Host)
+	return d.inner.Do(req)
+}
+
+func TestQuotaGuard_ServerOfClient(t *testing.T) {
+	doer := &hostRecordingDoer{inner: newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/rateLimits": {{status: http.StatusOK, body: `{"requestsLeft":3,"totalLimits":100}`}},
+	})}
+	keys := APIKeys{DataAnalytics: "da-key"}
+	guard := NewQuotaGuard(keys)
+
+	// the base URL is changed after the guard is attached
+	_, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithAPIKeys(keys),
+		WithQuotaGuard(guard),
+		WithBaseURL("https://sandbox.example.com"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := guard.Refresh(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if len(doer.hosts) != 1 || doer.hosts[0] != "sandbox.example.com" {
+		t.Fatalf("expected the quotas to be fetched from the server of the client, got %v", doer.hosts)
+	}
+
+	if _, err := NewClientWithResponses(ServerURL, WithQuotaGuard(guard)); err == nil {
+		t.Fatal("expected attaching the guard to a second client to fail")
+	}
+}
@@ -0,0 +1,147 @@
+package youscore
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimit allows at most Requests requests per Per.
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// DefaultRateLimits are the quotas of a standard YouScore contract:
+// 200 requests per minute and 50 requests every 5 seconds, per API key.
+var DefaultRateLimits = []RateLimit{
+	{Requests: 200, Per: time.Minute},
+	{Requests: 50, Per: 5 * time.Second},
+}
+
+// WithRateLimit returns a ClientOption that wraps the underlying HTTP client with a
+// client-side rate limiter, so that requests wait for their turn instead of getting a 429.
+// Each API key category (as selected by WithAPIKeys) has its own token buckets,
+// one per limit. Requests block until every bucket allows them, or ctx is done.
+//
+// If no limits are given, DefaultRateLimits are used. Enterprise contracts can pass their own.
+//
+// Apply this option before WithCache, so that cache hits do not consume tokens.
+func WithRateLimit(limits ...RateLimit) ClientOption {
+	if len(limits) == 0 {
+		limits = DefaultRateLimits
+	}
+	return func(c *Client) error {
+		inner := c.Client
+		if inner == nil {
+			inner = &http.Client{}
+		}
+		c.Client = &rateLimitDoer{
+			inner:   inner,
+			limits:  limits,
+			buckets: make(map[APIKeyCategory]*limiter),
+		}
+		return nil
+	}
+}
+
+type rateLimitDoer struct {
+	inner  HttpRequestDoer
+	limits []RateLimit
+
+	mu      sync.Mutex
+	buckets map[APIKeyCategory]*limiter
+}
+
+func (d *rateLimitDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := d.limiterFor(req).wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return d.inner.Do(req)
+}
+
+// limiterFor returns the limiter of the key category used by the request.
+func (d *rateLimitDoer) limiterFor(req *http.Request) *limiter {
+	category := KeyDataAnalytics
+	if op, ok := LookupOperation(req.Method, req.URL.EscapedPath()); ok {
+		category = op.Key
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	l, ok := d.buckets[category]
+	if !ok {
+		l = newLimiter(d.limits)
+		d.buckets[category] = l
+	}
+	return l
+}
+
+// limiter is a set of token buckets that must all have a token for a request to pass.
+type limiter struct {
+	mu      sync.Mutex
+	buckets []*bucket
+}
+
+type bucket struct {
+	capacity float64
+	perSec   float64 // refill rate in tokens per second
+	tokens   float64
+	last     time.Time
+}
+
+func newLimiter(limits []RateLimit) *limiter {
+	now := time.Now()
+	l := &limiter{}
+	for _, lim := range limits {
+		if lim.Requests <= 0 || lim.Per <= 0 {
+			continue
+		}
+		l.buckets = append(l.buckets, &bucket{
+			capacity: float64(lim.Requests),
+			perSec:   float64(lim.Requests) / lim.Per.Seconds(),
+			tokens:   float64(lim.Requests),
+			last:     now,
+		})
+	}
+	return l
+}
+
+// wait blocks until a token is available in every bucket and takes it.
+func (l *limiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve(time.Now())
+		if delay == 0 {
+			return nil
+		}
+		if err := sleepCtx(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token from every bucket if all of them have one and returns 0.
+// Otherwise it takes nothing and returns how long to wait before trying again.
+func (l *limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var delay time.Duration
+	for _, b := range l.buckets {
+		b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.perSec)
+		b.last = now
+		if b.tokens < 1 {
+			d := time.Duration((1 - b.tokens) / b.perSec * float64(time.Second))
+			delay = max(delay, d)
+		}
+	}
+	if delay > 0 {
+		return delay
+	}
+
+	for _, b := range l.buckets {
+		b.tokens--
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiter_Reserve(t *testing.T) {
+	l := newLimiter([]RateLimit{
+		{Requests: 2, Per: time.Second},
+		{Requests: 10, Per: time.Minute},
+	})
+	now := time.Now()
+
+	if d := l.reserve(now); d != 0 {
+		t.Fatalf("first request should pass, got delay %v", d)
+	}
+	if d := l.reserve(now); d != 0 {
+		t.Fatalf("second request should pass, got delay %v", d)
+	}
+	d := l.reserve(now)
+	if d <= 0 || d > 500*time.Millisecond {
+		t.Fatalf("third request should wait up to 500ms, got %v", d)
+	}
+	if d := l.reserve(now.Add(500 * time.Millisecond)); d != 0 {
+		t.Fatalf("request after refill should pass, got delay %v", d)
+	}
+}
+
+func TestWithRateLimit_PerKeyCategory(t *testing.T) {
+	fake := &fakeDoer{}
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(fake),
+		WithRateLimit(RateLimit{Requests: 1, Per: time.Hour}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := t.Context()
+	if _, err := cl.GetV1UsrContractorCode(ctx, "08215600", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// a different key category has its own bucket
+	if _, err := cl.GetV1ContractorsPdfFileContractorCode(ctx, "08215600", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// the data key is exhausted, so the request blocks until ctx is done
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err = cl.GetV1HistoryContractorCode(ctx, "08215600")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if fake.calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", fake.calls)
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -26,60 +30,100 @@ type RateLimits struct {
 	TotalLimits  int `json:"totalLimits"`
 }
 
-// CheckRateLimits for all (non-blank) keys
-func CheckRateLimits(ctx context.Context, keys APIKeys) (*RateLimitsResponse, error) {
+// RateLimitsError reports the keys whose rate limits could not be fetched by CheckRateLimits.
+type RateLimitsError struct {
+	Errors map[APIKeyCategory]error
+}
 
-	getLimit := func(ctx context.Context, key string) (*RateLimits, error) {
-		if key == "" {
-			return nil, nil
-		}
+func (e *RateLimitsError) Error() string {
+	categories := slices.Sorted(maps.Keys(e.Errors))
+	msgs := make([]string, 0, len(categories))
+	for _, category := range categories {
+		msgs = append(msgs, fmt.Sprintf("get %s key limit: %v", category, e.Errors[category]))
+	}
+	return strings.Join(msgs, "; ")
+}
 
-		// the rate limit endpoint defaults to the fallback API key, which is the DataAnalytics key
-		// so we create a new client that sets it
-		cl, err := NewClientWithResponses(ServerURL,
-			WithAPIKeys(APIKeys{
-				DataAnalytics: key,
-			}),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("new client: %w", err)
-		}
-		res, err := cl.GetV1RateLimitsWithResponse(ctx)
-		if err != nil {
-			return nil, err
-		}
-		if res.StatusCode() != http.StatusOK {
-			return nil, fmt.Errorf("bad status: %d", res.StatusCode())
-		}
+func (e *RateLimitsError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
 
-		var limits RateLimits
-		if err = json.Unmarshal(res.Body, &limits); err != nil {
-			return nil, fmt.Errorf("unmarshal: %w", err)
+// CheckRateLimits for all (non-blank) keys.
+// The keys are checked concurrently. opts are applied to the client used for each key,
+// so e.g. WithHTTPClient or WithBaseURL are respected.
+//
+// If some keys fail, the limits of the others are still returned,
+// together with a *RateLimitsError holding the error of each failed key.
+func CheckRateLimits(ctx context.Context, keys APIKeys, opts ...ClientOption) (*RateLimitsResponse, error) {
+	return checkRateLimits(ctx, ServerURL, keys, opts...)
+}
+
+func checkRateLimits(ctx context.Context, server string, keys APIKeys, opts ...ClientOption) (*RateLimitsResponse, error) {
+	categories := []APIKeyCategory{KeyDataAnalytics, KeyPDFLegalEntities, KeyPDFIndividuals, KeyAffiliates}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		limits = make(map[APIKeyCategory]*RateLimits)
+		errs   = make(map[APIKeyCategory]error)
+	)
+	for _, category := range categories {
+		key := keys.ForCategory(category)
+		if key == "" {
+			continue
 		}
-		return &limits, nil
+		wg.Go(func() {
+			l, err := fetchRateLimits(ctx, server, key, opts...)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[category] = err
+				return
+			}
+			limits[category] = l
+		})
 	}
+	wg.Wait()
 
-	dataLimits, err := getLimit(ctx, keys.DataAnalytics)
-	if err != nil {
-		return nil, fmt.Errorf("get data analytics key limit: %w", err)
+	res := &RateLimitsResponse{
+		DataAnalytics:    limits[KeyDataAnalytics],
+		PDFLegalEntities: limits[KeyPDFLegalEntities],
+		PDFIndividuals:   limits[KeyPDFIndividuals],
+		Affiliates:       limits[KeyAffiliates],
 	}
-	pdfEntitiesLimits, err := getLimit(ctx, keys.PDFLegalEntities)
-	if err != nil {
-		return nil, fmt.Errorf("get pdf legal entities key limit: %w", err)
+	if len(errs) > 0 {
+		return res, &RateLimitsError{Errors: errs}
 	}
-	pdfIndividualsLimits, err := getLimit(ctx, keys.PDFIndividuals)
+	return res, nil
+}
+
+// fetchRateLimits fetches the rate limits of a single key.
+func fetchRateLimits(ctx context.Context, server string, key string, opts ...ClientOption) (*RateLimits, error) {
+	// the rate limit endpoint defaults to the fallback API key, which is the DataAnalytics key
+	// so we create a new client that sets it
+	opts = append(slices.Clip(opts), WithAPIKeys(APIKeys{
+		DataAnalytics: key,
+	}))
+	cl, err := NewClientWithResponses(server, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("get pdf individuals key limit: %w", err)
+		return nil, fmt.Errorf("new client: %w", err)
 	}
-	affiliatesLimits, err := getLimit(ctx, keys.Affiliates)
+	res, err := cl.GetV1RateLimitsWithResponse(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("get affiliates key limit: %w", err)
+		return nil, err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("bad status: %d", res.StatusCode())
 	}
 
-	return &RateLimitsResponse{
-		DataAnalytics:    dataLimits,
-		PDFLegalEntities: pdfEntitiesLimits,
-		PDFIndividuals:   pdfIndividualsLimits,
-		Affiliates:       affiliatesLimits,
-	}, nil
+	var limits RateLimits
+	if err = json.Unmarshal(res.Body, &limits); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+	return &limits, nil
 }
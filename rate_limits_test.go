@@ -0,0 +1,59 @@
+package youscore
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// keyDoer answers the rate limits endpoint depending on the API key of the request.
+type keyDoer map[string]scriptedResponse
+
+func (d keyDoer) Do(req *http.Request) (*http.Response, error) {
+	r, ok := d[strings.TrimPrefix(req.Header.Get("Authorization"), "bearer ")]
+	if !ok {
+		r = scriptedResponse{status: http.StatusUnauthorized}
+	}
+	return &http.Response{
+		StatusCode: r.status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(r.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestCheckRateLimits_PartialResults(t *testing.T) {
+	doer := keyDoer{
+		"da-key":  {status: http.StatusOK, body: `{"requestsLeft":10,"totalLimits":100}`},
+		"aff-key": {status: http.StatusOK, body: `{"requestsLeft":5,"totalLimits":50}`},
+	}
+	keys := APIKeys{
+		DataAnalytics:  "da-key",
+		PDFIndividuals: "bad-key",
+		Affiliates:     "aff-key",
+	}
+
+	res, err := CheckRateLimits(t.Context(), keys, WithHTTPClient(doer))
+	var limitsErr *RateLimitsError
+	if !errors.As(err, &limitsErr) {
+		t.Fatalf("expected RateLimitsError, got %v", err)
+	}
+	if len(limitsErr.Errors) != 1 || limitsErr.Errors[KeyPDFIndividuals] == nil {
+		t.Fatalf("expected only the PDFIndividuals key to fail, got %v", limitsErr.Errors)
+	}
+
+	if res == nil {
+		t.Fatal("expected partial results")
+	}
+	if res.DataAnalytics == nil || res.DataAnalytics.RequestsLeft != 10 {
+		t.Errorf("unexpected DataAnalytics limits: %+v", res.DataAnalytics)
+	}
+	if res.Affiliates == nil || res.Affiliates.RequestsLeft != 5 {
+		t.Errorf("unexpected Affiliates limits: %+v", res.Affiliates)
+	}
+	if res.PDFIndividuals != nil || res.PDFLegalEntities != nil {
+		t.Errorf("expected no PDF limits, got %+v, %+v", res.PDFIndividuals, res.PDFLegalEntities)
+	}
+}
@@ -0,0 +1,763 @@
+// Code generated by spec/gen_results.go from client.gen.go DO NOT EDIT.
+
+package youscore
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1AffiliatesResultIdResponse) Result() (*[]YCApiModelsAffiliatesAffiliateRoot, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1BusinessPartnerContractorCodeResponse) Result() (*YCApiModelsResponseBusinessPartnerRegisterData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1CompanyPersonsIdResponse) Result() (*YCApiModelsResponsePepPerson, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1CompanyPersonsRelationsResponse) Result() (*YCApiCoreDataCompanyPersons, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1CompanyPersonsResponse) Result() (*[]YCApiModelsResponsePepPersonBaseInfo, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1ContractorsPdfFileContractorCodeResponse) Result() (*YCApiModelsResponseContractorPdf, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1CorruptedPersonsResultIdResponse) Result() (*YCApiModelsResponseNaturalPersonsCorruptedPersonsSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1CourtCaseGroupContractorCodeResponse) Result() (*YCApiModelsResponseCourtsCourtCaseGroupCourtCaseGroupData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1CourtContractorCodeResponse) Result() (*YCApiModelsCommonPagedResult1YCApiModelsResponseCourtsCourtInfo, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1EncumbrancesResultResultIdResponse) Result() (*[]YCApiModelsResponseEncumbrancesMovableEncumbranceDetailsApiResponse, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1EnforcementContractorCodeResponse) Result() (*YCApiModelsResponseEnforcementsEnforcementsRegisterData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1EnforcementIndividualResultIdResponse) Result() (*YCApiModelsCommonPagedResult1YCApiModelsResponseEnforcementsEnforcementIndividualInfo, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1ExpressAnalysisAggressorsContractorCodeResponse) Result() (*YCApiModelsResponseExpressAnalysisAggressorsFactors, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1ExpressAnalysisContractorCodeResponse) Result() (*YCApiModelsResponseExpressAnalysis, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1ExpressAnalysisFinmonContractorCodeResponse) Result() (*YCApiModelsResponseExpressAnalysisFinMonFactors, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1ExternalEconomiesContractorCodeResponse) Result() (*YCApiModelsResponseExternalEconomiesExternalEconomiesRegisterData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1ExternalEconomiesContractorCodeYearsYearResponse) Result() (*YCApiModelsResponseExternalEconomiesExternalEconomiesInfoByYear, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1FigIdResponse) Result() (*YCApiModelsResponseFinancialIndustrialGroup, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1FigResponse) Result() (*YCApiModelsResponseFinancialIndustrialGroupDescriptor, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1FinancialIndicatorsContractorCodeResponse) Result() (*YCApiModelsResponseFinancialIndicatorsByYear, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1FinancialIndicatorsContractorCodeYearsYearResponse) Result() (*YCApiModelsResponseFinancialIndicatorsQuarterReport1YCApiModelsResponseAttachmentFinancialIndicatorsData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1FinancialScoringContractorCodeResponse) Result() (*YCApiModelsResponseFinScoreByYear, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1FinancialScoringContractorCodeYearsYearResponse) Result() (*YCApiModelsResponseFinancialScoringYearReport, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1Generalprosecutor24febsuspectResponse) Result() (*YCApiModelsResponseGovFinMonFinMonGovInvestigationResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1HistoryContractorCodeResponse) Result() (*YCApiModelsResponseHistoryContractorHistory, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsCecResultIdResponse) Result() (*YCApiModelsResponseIndividualsCecSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsCourtCasesToBeHeardResultIdResponse) Result() (*YCApiModelsResponseCourtsIndividualsCourtCasesToBeHeardSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsCourtStatusOfTheCaseResultIdResponse) Result() (*YCApiModelsResponseCourtsIndividualsCourtStatusOfTheCaseSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsDsfmuTerroristsRecordTypesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsDsfmuTerroristsResultIdResponse) Result() (*YCApiModelsResponseIndividualsDsfmuTerroristsSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsFgvfoDebtorsResultIdResponse) Result() (*YCApiModelsResponseIndividualsFgvfoDebtorsSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsFigCompaniesRelationTypesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsFigCompaniesResultIdResponse) Result() (*YCApiModelsResponseIndividualsFigCompaniesSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsFullNameInfoCoincidenceStatusesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsFullNameInfoResultIdResponse) Result() (*YCApiModelsResponseIndividualsFullNameInfoCheckResult, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsGlobalSanctionsListsResponse) Result() (*YCApiModelsResponseSanctionsIndividualsGlobalSanctionsResponseModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsPdfReportsResultIdResponse) Result() (*YCApiModelsResponseIndividualsPdfReportsResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRelatedPersonsByCodeAssotiationTypesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRelatedPersonsByCodeContractorStatusesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRelatedPersonsByCodeResultIdResponse) Result() (*YCApiModelsResponseIndividualsRelatedPersonsSearchByCodeResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRelatedPersonsContractorStatusesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRelatedPersonsContractorTypesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRelatedPersonsRelationStatusesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRelatedPersonsRelationTypesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRelatedPersonsResultIdResponse) Result() (*YCApiModelsResponseIndividualsRelatedPersonsSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsRnboSanctionsResultIdExtendedResponse) Result() (*YCApiModelsResponseRnboSanctionsIndividualsRnboSanctionsSubjectsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsSsuWantedAndTraitorPersonsGendersResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsSsuWantedAndTraitorPersonsResultIdResponse) Result() (*YCApiModelsResponseIndividualsSsuWantedAndTraitorPersonsSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1IndividualsTaxDebtorsResultIdResponse) Result() (*YCApiModelsResponseIndividualsTaxDebtorsSearchResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1InvestigationDetailsResponse) Result() (*YCApiModelsResponseInvestigationsMediaReputationDetails, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1InvestigationsLegalResponse) Result() (*YCApiModelsResponseInvestigationsMediaReputation, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1InvestigationsNaturalResponse) Result() (*YCApiModelsResponseInvestigationsMediaReputation, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1LicensesLicenseCodeResponse) Result() (*YCApiModelsResponseLicense, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1LicensesRegistersListResponse) Result() (*[]YCApiModelsResponseLicensesLicenseRegistry, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1LicensesRelevanceResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1LicensesResponse) Result() (*YCApiModelsResponseLicenseShortInfo, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1LustratedPersonsResponse) Result() (*YCApiModelsResponseLustratedPersonsSummary, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1MarketScoringContractorCodeResponse) Result() (*YCApiModelsResponseMarketScoreByYear, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1MarketScoringContractorCodeYearsYearResponse) Result() (*YCApiModelsResponseMarketScoreYearReport, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1MyrotvoretsResponse) Result() (*YCApiModelsResponsePeacemakerPeacemakerResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1NacpwarsanctionsResponse) Result() (*YCApiModelsResponseNazkNazkSanctionsResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1NonProfitCompaniesContractorCodeResponse) Result() (*YCApiModelsResponseNonProfitCompaniesNonProfitCompanyData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1PassportsResponse) Result() (*YCApiModelsResponsePassports, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1PepsExtendedInfoResponse) Result() (*YCApiModelsResponseGroupedDeclarantsAndPepsGroupedDeclarantAndPepCheckResult, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1PepsRelatedResponse) Result() (*YCApiModelsResponseNationalPublicPersonsAllRelatedToNationalPublicPersonResults, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1PepsResponse) Result() (*YCApiModelsResponseNationalPublicPersonsNationalPublicPersonResults, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1PepsforeignRelatedResponse) Result() (*YCApiModelsResponseOpenSanctionsAllRelatedToNationalPublicPersonResults, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1PepsforeignResponse) Result() (*YCApiModelsResponseOpenSanctionsNationalPublicPersonResults, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1RealEstateDataTypesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1RealEstateResultResultIdResponse) Result() (*[]YCApiModelsResponseRealEstateRealEstateDescriptor, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1RuswarcriminalsResponse) Result() (*YCApiModelsResponseRusWarCriminalsRusWarCriminalsResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1SanctionsResponse) Result() (*YCApiModelsResponseContractorSanction, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1SecouResponse) Result() (*[]YCApiModelsResponseContractorSecou, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1SetamAuctionsProceedingsNumberResponse) Result() (*YCApiModelsResponseSetamAuctionsResponseModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1SetamAuctionsResponse) Result() (*YCApiModelsCommonPagedResult1YCApiModelsResponseSetamAuctionResponseModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1ShareholdersContractorCodeResponse) Result() (*[]YCApiModelsResponseShareholders, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1SingleTaxContractorCodeResponse) Result() (*YCApiModelsResponseSingleTaxRegisterData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1StaffContractorCodeResponse) Result() (*[]YCApiModelsResponseContractorStaff, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1TaxDebtContractorCodeResponse) Result() (*YCApiModelsResponseContractorTaxDebt, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1TendersContractStatusesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1TendersContractsTenderIdResponse) Result() (*YCApiModelsResponseTendersTendersContractsData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1TendersProcedureTypesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1TendersProceduresResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1TendersRisksJournalIdResponse) Result() (*YCApiModelsResponseTendersCheckTenderJournalRecordInfoFullModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1TendersRisksStartTenderIdResponse) Result() (*YCApiModelsResponseTendersCheckTenderApiRisksStartResponseModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1TendersStatusesResponse) Result() (*map[string]string, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1UsrAdministrativeServicesResultsCodeResponse) Result() (*YCApiModelsResponseUsrAdministrativeServicesAdministrativeServicesResultsModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1UsrContractorCodeResponse) Result() (*YCApiModelsResponseUsrLegalPersonRegisterData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1UsrDocumentsUsrOwnershipStructureFileResponse) Result() (*YCApiModelsResponseUsrUsrDocumentsUsrDocumentResultModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1UsrDocumentsUsrStatutFileResponse) Result() (*YCApiModelsResponseUsrUsrDocumentsUsrDocumentResultModel, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1VatCanceledContractorCodeResponse) Result() (*YCApiModelsResponseVatCanceledRegisterData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1VatContractorCodeResponse) Result() (*YCApiModelsResponseVatRegisterData, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1VehiclesCheckResponse) Result() (*YCApiSwaggerResponseExamplesVehicleCheckResultExample, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1VehiclesOwnedResponse) Result() (*YCApiModelsCommonPagedResult1YCApiModelsResponseOwnedVehicle, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
+
+// Result returns the JSON200 payload, or an *APIError if the status is not 200.
+func (r *GetV1WantedOrDisappearedPersonsResponse) Result() (*YCApiModelsResponseWantedAndDisappearedPersons, error) {
+	if err := CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		return nil, err
+	}
+	return r.JSON200, nil
+}
@@ -0,0 +1,211 @@
+package youscore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how WithRetry retries 429 "Too Many Requests" and 5xx responses.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of requests per call, including the first one.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry. It doubles after every attempt, up to MaxDelay.
+	// The actual delay is randomised between half and all of the backoff.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff. A Retry-After header is honoured even if it is longer.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is used by WithRetry for the zero fields of the given policy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// RetryError is returned once WithRetry has used up all attempts.
+type RetryError struct {
+	// Attempts is the number of requests sent.
+	Attempts int
+	// StatusCode is the status of the last response, or 0 if the last attempt failed with Err.
+	StatusCode int
+	// Err is the transport error of the last attempt, if any.
+	Err error
+}
+
+func (e *RetryError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("youscore: giving up after %d attempts: %v", e.Attempts, e.Err)
+	}
+	return fmt.Sprintf("youscore: giving up after %d attempts: status %d", e.Attempts, e.StatusCode)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the status of the last response matches one of the sentinel errors,
+// e.g. ErrRateLimited after the 429 retries are used up.
+func (e *RetryError) Is(target error) bool {
+	if e.StatusCode == 0 {
+		return false
+	}
+	return (&APIError{StatusCode: e.StatusCode}).Is(target)
+}
+
+// WithRetry returns a ClientOption that wraps the underlying HTTP client and retries
+// 429 "Too Many Requests" and 5xx responses. The Retry-After header is honoured when present,
+// otherwise exponential backoff with jitter is used.
+//
+// 429 responses are retried for every method, since the request was not processed.
+// 5xx responses and transport errors are only retried for GET requests, to avoid
+// running a billable POST (e.g. PostV1AffiliatesQuery) twice.
+//
+// Request bodies are buffered, so they can be replayed. As with WithInProgressRetry, the client
+// request editors are applied again on every retry. Once all attempts are used up,
+// the call fails with a *RetryError.
+//
+// Apply this option after WithRateLimit and before WithCache.
+func WithRetry(policy RetryPolicy) ClientOption {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if policy.BaseDelay <= 0 {
+		policy.BaseDelay = DefaultRetryPolicy.BaseDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultRetryPolicy.MaxDelay
+	}
+	return func(c *Client) error {
+		inner := c.Client
+		if inner == nil {
+			inner = &http.Client{}
+		}
+		c.Client = &retryDoer{
+			inner:  inner,
+			client: c,
+			policy: policy,
+		}
+		return nil
+	}
+}
+
+type retryDoer struct {
+	inner  HttpRequestDoer
+	client *Client
+	policy RetryPolicy
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	bodyBytes, err := bufferBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := d.inner.Do(req)
+		if !d.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		var retryAfter time.Duration
+		if resp != nil {
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if attempt >= d.policy.MaxAttempts {
+			retryErr := &RetryError{Attempts: attempt, Err: err}
+			if resp != nil {
+				retryErr.StatusCode = resp.StatusCode
+			}
+			return nil, retryErr
+		}
+
+		delay := retryAfter
+		if delay <= 0 {
+			delay = backoff(d.policy, attempt)
+		}
+		if err := sleepCtx(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		req, err = nextAttempt(d.client, req, bodyBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// nextAttempt clones req for a retry, with a fresh copy of body, and applies the request
+// editors of c again, so that they see every attempt. It counts the retry in the callRecord.
+func nextAttempt(c *Client, req *http.Request, body []byte) (*http.Request, error) {
+	if rec := callRecordFromContext(req.Context()); rec != nil {
+		rec.retries++
+	}
+	ctx := context.WithValue(req.Context(), attemptCtxKey{}, RequestAttempt(req.Context())+1)
+	next := req.Clone(ctx)
+	if body != nil {
+		next.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	for _, edit := range c.RequestEditors {
+		if err := edit(ctx, next); err != nil {
+			return nil, err
+		}
+	}
+	return next, nil
+}
+
+// shouldRetry reports whether the outcome of a request is worth retrying.
+func (d *retryDoer) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return req.Method == http.MethodGet
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= 500:
+		return req.Method == http.MethodGet
+	}
+	return false
+}
+
+// backoff returns the jittered exponential backoff before the given retry attempt.
+func backoff(policy RetryPolicy, attempt int) time.Duration {
+	delay := policy.BaseDelay << (attempt - 1)
+	if delay <= 0 || (policy.MaxDelay > 0 && delay > policy.MaxDelay) {
+		delay = policy.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+// It returns 0 if the header is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(secs)*time.Second, 0)
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(now), 0)
+	}
+	return 0
+}
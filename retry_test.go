@@ -0,0 +1,202 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+// bodyRecordingDoer wraps a doer and records the request bodies it receives.
+type bodyRecordingDoer struct {
+	inner  HttpRequestDoer
+	bodies []string
+}
+
+func (d *bodyRecordingDoer) Do(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		d.bodies = append(d.bodies, string(b))
+	}
+	return d.inner.Do(req)
+}
+
+func TestWithRetry_TooManyRequests(t *testing.T) {
+	doer := &bodyRecordingDoer{inner: newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/affiliates/query": {
+			{status: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"0"}}},
+			{status: http.StatusAccepted, body: `{"id":"1"}`},
+		},
+	})}
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cl.PostV1AffiliatesQueryWithResponse(t.Context(), PostV1AffiliatesQueryJSONRequestBody{ContractorCode: "08215600"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", res.StatusCode())
+	}
+	if len(doer.bodies) != 2 || doer.bodies[0] == "" || doer.bodies[0] != doer.bodies[1] {
+		t.Fatalf("expected the body to be replayed, got %q", doer.bodies)
+	}
+}
+
+func TestWithRetry_AttemptsAndEditors(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {
+			{status: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"0"}}},
+			{status: http.StatusServiceUnavailable, header: http.Header{"Retry-After": {"0"}}},
+			{status: http.StatusOK, body: `{"code":"08215600"}`},
+		},
+	})
+	var (
+		seen  []int
+		usage Usage
+	)
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithRequestEditorFn(func(ctx context.Context, _ *http.Request) error {
+			seen = append(seen, RequestAttempt(ctx))
+			return nil
+		}),
+		WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+		WithUsageHook(func(_ context.Context, u Usage) { usage = u }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(seen, []int{1, 2, 3}) {
+		t.Fatalf("expected the editors to see every attempt, got %v", seen)
+	}
+	if usage.Attempts != 3 {
+		t.Fatalf("expected 3 attempts in the usage, got %d", usage.Attempts)
+	}
+}
+
+func TestWithRetry_Exhausted(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {{status: http.StatusBadGateway}},
+	})
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("expected RetryError, got %v", err)
+	}
+	if retryErr.Attempts != 3 || retryErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("unexpected error: %+v", retryErr)
+	}
+	if got := doer.count("/v1/usr/08215600"); got != 3 {
+		t.Fatalf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestWithRetry_ExhaustedRateLimited(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {{status: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"0"}}}},
+	})
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithRetry(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Fatal("expected only ErrRateLimited to match")
+	}
+}
+
+func TestWithRetry_ZeroFieldsDefaulted(t *testing.T) {
+	c, err := NewClient(ServerURL, WithRetry(RetryPolicy{MaxAttempts: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := c.Client.(*retryDoer).policy
+	want := RetryPolicy{MaxAttempts: 2, BaseDelay: DefaultRetryPolicy.BaseDelay, MaxDelay: DefaultRetryPolicy.MaxDelay}
+	if policy != want {
+		t.Fatalf("expected %+v, got %+v", want, policy)
+	}
+}
+
+func TestWithRetry_NoRetryOfPostOnServerError(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/affiliates/query": {{status: http.StatusInternalServerError}},
+	})
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cl.PostV1AffiliatesQueryWithResponse(t.Context(), PostV1AffiliatesQueryJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusInternalServerError {
+		t.Fatalf("expected 500, got %d", res.StatusCode())
+	}
+	if got := doer.count("/v1/affiliates/query"); got != 1 {
+		t.Fatalf("expected 1 call, got %d", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"", 0},
+		{"3", 3 * time.Second},
+		{"-1", 0},
+		{"Wed, 01 Jan 2025 12:00:10 GMT", 10 * time.Second},
+		{"garbage", 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.in, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt, want := range map[int]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 10: time.Second} {
+		got := backoff(policy, attempt)
+		if got < want/2 || got > want {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", attempt, got, want/2, want)
+		}
+	}
+}
@@ -0,0 +1,405 @@
+// Package risk derives a counterparty risk summary from YouScore responses.
+//
+// Assess maps the typed responses of the express analysis, financial and market scoring,
+// sanctions, tax debt and bankruptcy (secou) endpoints onto flags with a normalised Level,
+// and combines them into an overall level with pluggable Rules:
+//
+//	report := risk.Assess(risk.Input{
+//		ExpressAnalysis: analysis,
+//		TaxDebt:         taxDebt,
+//	}, risk.WithRules(risk.Weighted(risk.DefaultWeights), risk.Threshold(3, risk.LevelHigh, risk.LevelCritical)))
+//
+// Assess does no I/O and is deterministic for the same input.
+package risk
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/fritzkeyzer/goyouscore"
+)
+
+// Level is a normalised risk level, ordered from LevelUnknown to LevelCritical.
+type Level int
+
+const (
+	// LevelUnknown means there was no data to assess.
+	LevelUnknown Level = iota
+	LevelLow
+	LevelMedium
+	LevelHigh
+	LevelCritical
+)
+
+var levelNames = []string{"unknown", "low", "medium", "high", "critical"}
+
+func (l Level) String() string {
+	if l < LevelUnknown || l > LevelCritical {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// Source is the endpoint a Flag was derived from.
+type Source string
+
+const (
+	SourceExpressAnalysis  Source = "expressAnalysis"
+	SourceFinancialScoring Source = "financialScoring"
+	SourceMarketScoring    Source = "marketScoring"
+	SourceSanctions        Source = "sanctions"
+	SourceTaxDebt          Source = "taxDebt"
+	SourceBankruptcy       Source = "bankruptcy"
+)
+
+// Flag is a single risk factor.
+type Flag struct {
+	Source Source
+	// Factor identifies the flag within its source, e.g. the express analysis factor name,
+	// the sanctions list or the bankruptcy case number.
+	Factor      string
+	Level       Level
+	Description string
+	// Date is the date of the underlying data, zero if the response has none.
+	// Scores are dated at the end of their year.
+	Date time.Time
+}
+
+// Input holds the responses to assess. A nil field means the source was not checked,
+// and is listed in Report.Missing. An empty slice, or a sanction without a source and description,
+// means the source was checked and found nothing.
+type Input struct {
+	ExpressAnalysis *youscore.YCApiModelsResponseExpressAnalysis
+	// FinancialScoring and MarketScoring hold the scores by year, the latest year is assessed.
+	FinancialScoring *[]youscore.YCApiModelsResponseFinScoreByYear
+	MarketScoring    *[]youscore.YCApiModelsResponseMarketScoreByYear
+	// Sanctions is the response of GET /v1/sanctions.
+	Sanctions *youscore.YCApiModelsResponseContractorSanction
+	TaxDebt   *youscore.YCApiModelsResponseContractorTaxDebt
+	// Bankruptcy holds the publications of the secou endpoint.
+	Bankruptcy *[]youscore.YCApiModelsResponseContractorSecou
+}
+
+// Report is the outcome of Assess.
+type Report struct {
+	// Level is the highest level returned by the Rules, LevelUnknown if no source was checked.
+	Level Level
+	// Flags are sorted by level (highest first), source, factor and date.
+	Flags []Flag
+	// Missing lists the sources that were not checked, Level does not account for them.
+	Missing []Source
+}
+
+// Rule derives an overall Level from the flags of a report.
+type Rule func(flags []Flag) Level
+
+// DefaultWeights are the weights of the default Weighted rule.
+// Scores are indicators rather than findings and count half: a LevelHigh or
+// LevelCritical score counts as LevelMedium, see Weighted.
+var DefaultWeights = map[Source]float64{
+	SourceExpressAnalysis:  1,
+	SourceFinancialScoring: 0.5,
+	SourceMarketScoring:    0.5,
+	SourceSanctions:        1,
+	SourceTaxDebt:          1,
+	SourceBankruptcy:       1,
+}
+
+// DefaultTaxDebtThreshold is the tax debt in UAH from which a debt is a LevelHigh flag,
+// smaller debts are LevelMedium.
+const DefaultTaxDebtThreshold = 100_000
+
+// Weighted returns a Rule that scales the level of every flag by the weight of its source,
+// rounded to the nearest level, and returns the highest result.
+// Sources without a weight count fully.
+//
+// Levels are scaled as ordinals, LevelLow (1) to LevelCritical (4), not as risk scores:
+// at weight 0.5 a LevelHigh flag (e.g. a D financial score) becomes 1.5, rounded to LevelMedium,
+// and a LevelCritical flag becomes LevelMedium as well.
+func Weighted(weights map[Source]float64) Rule {
+	return func(flags []Flag) Level {
+		level := LevelLow
+		for _, f := range flags {
+			w, ok := weights[f.Source]
+			if !ok {
+				w = 1
+			}
+			level = max(level, Level(math.Round(float64(f.Level)*w)))
+		}
+		return min(level, LevelCritical)
+	}
+}
+
+// Threshold returns a Rule that returns level if at least n flags are at least minLevel,
+// e.g. Threshold(3, LevelHigh, LevelCritical) escalates three high flags to critical.
+func Threshold(n int, minLevel, level Level) Rule {
+	return func(flags []Flag) Level {
+		count := 0
+		for _, f := range flags {
+			if f.Level >= minLevel {
+				count++
+			}
+		}
+		if count >= n {
+			return level
+		}
+		return LevelLow
+	}
+}
+
+// Option configures Assess.
+type Option func(*config)
+
+type config struct {
+	rules            []Rule
+	taxDebtThreshold float64
+}
+
+// WithRules replaces the default rule, Weighted(DefaultWeights).
+// The overall level is the highest level returned by any rule.
+func WithRules(rules ...Rule) Option {
+	return func(c *config) {
+		c.rules = rules
+	}
+}
+
+// WithTaxDebtThreshold sets the tax debt in UAH from which a debt is a LevelHigh flag,
+// see DefaultTaxDebtThreshold.
+func WithTaxDebtThreshold(uah float64) Option {
+	return func(c *config) {
+		c.taxDebtThreshold = uah
+	}
+}
+
+// Assess derives the risk Report of a counterparty.
+func Assess(in Input, opts ...Option) Report {
+	c := config{
+		rules:            []Rule{Weighted(DefaultWeights)},
+		taxDebtThreshold: DefaultTaxDebtThreshold,
+	}
+	for _, o := range opts {
+		o(&c)
+	}
+
+	var r Report
+	check := func(source Source, provided bool, flags func() []Flag) {
+		if !provided {
+			r.Missing = append(r.Missing, source)
+			return
+		}
+		r.Flags = append(r.Flags, flags()...)
+	}
+	check(SourceExpressAnalysis, in.ExpressAnalysis != nil, func() []Flag { return expressAnalysisFlags(in.ExpressAnalysis) })
+	check(SourceFinancialScoring, in.FinancialScoring != nil, func() []Flag { return financialScoringFlags(*in.FinancialScoring) })
+	check(SourceMarketScoring, in.MarketScoring != nil, func() []Flag { return marketScoringFlags(*in.MarketScoring) })
+	check(SourceSanctions, in.Sanctions != nil, func() []Flag { return sanctionFlags(in.Sanctions) })
+	check(SourceTaxDebt, in.TaxDebt != nil, func() []Flag { return taxDebtFlags(in.TaxDebt, c.taxDebtThreshold) })
+	check(SourceBankruptcy, in.Bankruptcy != nil, func() []Flag { return bankruptcyFlags(*in.Bankruptcy) })
+
+	slices.SortFunc(r.Flags, func(a, b Flag) int {
+		return cmp.Or(
+			cmp.Compare(b.Level, a.Level),
+			cmp.Compare(a.Source, b.Source),
+			cmp.Compare(a.Factor, b.Factor),
+			a.Date.Compare(b.Date),
+		)
+	})
+
+	if len(r.Missing) == len(allSources) {
+		return r
+	}
+	r.Level = LevelLow
+	for _, rule := range c.rules {
+		r.Level = max(r.Level, rule(r.Flags))
+	}
+	return r
+}
+
+// allSources are all sources assessed by Assess.
+var allSources = []Source{
+	SourceExpressAnalysis,
+	SourceFinancialScoring,
+	SourceMarketScoring,
+	SourceSanctions,
+	SourceTaxDebt,
+	SourceBankruptcy,
+}
+
+// markLevels maps the A–D marks of the express analysis index and the scores, A being the best.
+var markLevels = map[string]Level{
+	"A": LevelLow,
+	"B": LevelMedium,
+	"C": LevelHigh,
+	"D": LevelCritical,
+}
+
+// cautionLevels maps the caution of an express analysis factor.
+var cautionLevels = map[string]Level{
+	"max":     LevelHigh,
+	"average": LevelMedium,
+}
+
+func expressAnalysisFlags(ea *youscore.YCApiModelsResponseExpressAnalysis) []Flag {
+	date := deref(ea.ActualDate)
+
+	var flags []Flag
+	if level, ok := markLevels[deref(ea.ExpressAnalysisIndex)]; ok && level > LevelLow {
+		flags = append(flags, Flag{
+			Source:      SourceExpressAnalysis,
+			Factor:      "expressAnalysisIndex",
+			Level:       level,
+			Description: "express analysis index " + *ea.ExpressAnalysisIndex,
+			Date:        date,
+		})
+	}
+
+	if ea.Features == nil || len(*ea.Features) == 0 {
+		// only the totals are known
+		for _, total := range []struct {
+			factor string
+			n      *int32
+			level  Level
+		}{
+			{"totalMaxCaution", ea.TotalMaxCaution, LevelHigh},
+			{"totalAverageCaution", ea.TotalAverageCaution, LevelMedium},
+		} {
+			if n := deref(total.n); n > 0 {
+				flags = append(flags, Flag{
+					Source:      SourceExpressAnalysis,
+					Factor:      total.factor,
+					Level:       total.level,
+					Description: fmt.Sprintf("%d factors", n),
+					Date:        date,
+				})
+			}
+		}
+		return flags
+	}
+
+	for _, f := range *ea.Features {
+		level, ok := cautionLevels[deref(f.Caution)]
+		if !ok {
+			continue
+		}
+		flags = append(flags, Flag{
+			Source:      SourceExpressAnalysis,
+			Factor:      deref(f.FeatureName),
+			Level:       level,
+			Description: deref(f.Value),
+			Date:        cmp.Or(deref(f.ActualDate), date),
+		})
+	}
+	return flags
+}
+
+func financialScoringFlags(scores []youscore.YCApiModelsResponseFinScoreByYear) []Flag {
+	latest, ok := latestYear(scores, func(s youscore.YCApiModelsResponseFinScoreByYear) int32 { return deref(s.Year) })
+	if !ok || latest.Score == nil {
+		return nil
+	}
+	return scoreFlag(SourceFinancialScoring, "finScore", deref(latest.Score.Mark), deref(latest.Year))
+}
+
+func marketScoringFlags(scores []youscore.YCApiModelsResponseMarketScoreByYear) []Flag {
+	latest, ok := latestYear(scores, func(s youscore.YCApiModelsResponseMarketScoreByYear) int32 { return deref(s.Year) })
+	if !ok || latest.Score == nil {
+		return nil
+	}
+	return scoreFlag(SourceMarketScoring, "marketScore", deref(latest.Score.Mark), deref(latest.Year))
+}
+
+func scoreFlag(source Source, factor string, mark string, year int32) []Flag {
+	level, ok := markLevels[mark]
+	if !ok || level == LevelLow {
+		return nil
+	}
+	return []Flag{{
+		Source:      source,
+		Factor:      factor,
+		Level:       level,
+		Description: fmt.Sprintf("%s %s in %d", factor, mark, year),
+		Date:        time.Date(int(year), time.December, 31, 0, 0, 0, 0, time.UTC),
+	}}
+}
+
+// sanctionFlags flags a sanction, if the response names one: the endpoint answers
+// with an empty sanction when the company is not sanctioned.
+func sanctionFlags(s *youscore.YCApiModelsResponseContractorSanction) []Flag {
+	if deref(s.Source) == "" && deref(s.Description) == "" {
+		return nil
+	}
+	return []Flag{{
+		Source:      SourceSanctions,
+		Factor:      deref(s.Source),
+		Level:       LevelCritical,
+		Description: deref(s.Description),
+		Date:        deref(s.Date),
+	}}
+}
+
+func taxDebtFlags(debt *youscore.YCApiModelsResponseContractorTaxDebt, threshold float64) []Flag {
+	amount := deref(debt.Debt)
+	if amount <= 0 {
+		return nil
+	}
+	level := LevelMedium
+	if amount >= threshold {
+		level = LevelHigh
+	}
+	return []Flag{{
+		Source:      SourceTaxDebt,
+		Factor:      "taxDebt",
+		Level:       level,
+		Description: fmt.Sprintf("tax debt of %.2f UAH", amount),
+		Date:        deref(debt.ActualDate),
+	}}
+}
+
+// bankruptcyFlags returns a flag per bankruptcy case, dated at its latest publication.
+func bankruptcyFlags(publications []youscore.YCApiModelsResponseContractorSecou) []Flag {
+	cases := make(map[string]Flag)
+	for _, p := range publications {
+		caseNumber := deref(p.CaseNumber)
+		date := deref(p.Date)
+		if f, ok := cases[caseNumber]; ok && !date.After(f.Date) {
+			continue
+		}
+		cases[caseNumber] = Flag{
+			Source:      SourceBankruptcy,
+			Factor:      caseNumber,
+			Level:       LevelCritical,
+			Description: fmt.Sprintf("%s: %s", deref(p.Court), deref(p.Type)),
+			Date:        date,
+		}
+	}
+
+	var flags []Flag
+	for _, f := range cases {
+		flags = append(flags, f)
+	}
+	return flags
+}
+
+func latestYear[T any](scores []T, year func(T) int32) (T, bool) {
+	var latest T
+	if len(scores) == 0 {
+		return latest, false
+	}
+	return slices.MaxFunc(scores, func(a, b T) int { return cmp.Compare(year(a), year(b)) }), true
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
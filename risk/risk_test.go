@@ -0,0 +1,191 @@
+package risk
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/fritzkeyzer/goyouscore"
+	"github.com/fritzkeyzer/goyouscore/youscoretest"
+)
+
+func loadInput(t *testing.T, name string) Input {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var in Input
+	if err := json.Unmarshal(data, &in); err != nil {
+		t.Fatal(err)
+	}
+	return in
+}
+
+func TestAssess_Distressed(t *testing.T) {
+	r := Assess(loadInput(t, "distressed.json"))
+
+	if r.Level != LevelCritical {
+		t.Fatalf("expected critical, got %s", r.Level)
+	}
+	if len(r.Missing) != 0 {
+		t.Fatalf("expected no missing sources, got %v", r.Missing)
+	}
+
+	type flag struct {
+		source Source
+		factor string
+		level  Level
+		date   string
+	}
+	want := []flag{
+		{SourceBankruptcy, "923/1062/14", LevelCritical, "2019-04-10"},
+		{SourceFinancialScoring, "finScore", LevelCritical, "2024-12-31"},
+		{SourceExpressAnalysis, "expressAnalysisIndex", LevelHigh, "2025-06-01"},
+		{SourceExpressAnalysis, "Податковий борг", LevelHigh, "2025-05-20"},
+		{SourceTaxDebt, "taxDebt", LevelHigh, "2025-05-01"},
+		{SourceExpressAnalysis, "Зміна директора", LevelMedium, "2025-06-01"},
+		{SourceMarketScoring, "marketScore", LevelMedium, "2024-12-31"},
+	}
+	var got []flag
+	for _, f := range r.Flags {
+		got = append(got, flag{f.Source, f.Factor, f.Level, f.Date.Format(time.DateOnly)})
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected flags:\n got %v\nwant %v", got, want)
+	}
+	if r.Flags[0].Description != "Господарський суд Херсонської області: Повідомлення про результати проведення аукціону з продажу майна" {
+		t.Fatalf("expected the latest publication of the case, got %q", r.Flags[0].Description)
+	}
+}
+
+func TestAssess_Clean(t *testing.T) {
+	r := Assess(loadInput(t, "clean.json"))
+
+	if r.Level != LevelLow {
+		t.Fatalf("expected low, got %s", r.Level)
+	}
+	if len(r.Flags) != 1 || r.Flags[0].Source != SourceFinancialScoring || r.Flags[0].Level != LevelMedium {
+		t.Fatalf("expected a single medium finScore flag, got %+v", r.Flags)
+	}
+	if !slices.Equal(r.Missing, []Source{SourceMarketScoring}) {
+		t.Fatalf("expected marketScoring to be missing, got %v", r.Missing)
+	}
+}
+
+func TestAssess_Rules(t *testing.T) {
+	in := loadInput(t, "clean.json")
+	r := Assess(in, WithRules(Weighted(map[Source]float64{SourceFinancialScoring: 1})))
+	if r.Level != LevelMedium {
+		t.Fatalf("expected medium with a full weight on scoring, got %s", r.Level)
+	}
+
+	in = loadInput(t, "distressed.json")
+	in.Bankruptcy = &[]youscore.YCApiModelsResponseContractorSecou{}
+	in.FinancialScoring = nil
+	r = Assess(in, WithRules(Weighted(DefaultWeights)))
+	if r.Level != LevelHigh {
+		t.Fatalf("expected high, got %s", r.Level)
+	}
+	r = Assess(in, WithRules(Weighted(DefaultWeights), Threshold(3, LevelHigh, LevelCritical)))
+	if r.Level != LevelCritical {
+		t.Fatalf("expected three high flags to escalate to critical, got %s", r.Level)
+	}
+}
+
+func TestAssess_TaxDebtThreshold(t *testing.T) {
+	debt := 5000.0
+	in := Input{TaxDebt: &youscore.YCApiModelsResponseContractorTaxDebt{Debt: &debt}}
+
+	if r := Assess(in); r.Level != LevelMedium {
+		t.Fatalf("expected medium, got %s", r.Level)
+	}
+	if r := Assess(in, WithTaxDebtThreshold(1000)); r.Level != LevelHigh {
+		t.Fatalf("expected high, got %s", r.Level)
+	}
+}
+
+func TestAssess_Sanctions(t *testing.T) {
+	r := Assess(Input{Sanctions: &youscore.YCApiModelsResponseContractorSanction{}})
+	if len(r.Flags) != 0 || slices.Contains(r.Missing, SourceSanctions) {
+		t.Fatalf("expected an empty sanction to be checked without flags, got %+v", r)
+	}
+
+	source := "РНБО"
+	r = Assess(Input{Sanctions: &youscore.YCApiModelsResponseContractorSanction{Source: &source}})
+	if r.Level != LevelCritical || len(r.Flags) != 1 || r.Flags[0].Factor != source {
+		t.Fatalf("expected a critical sanctions flag, got %+v", r)
+	}
+}
+
+func TestWeighted_ScalesOrdinals(t *testing.T) {
+	rule := Weighted(map[Source]float64{SourceFinancialScoring: 0.5})
+	for level, want := range map[Level]Level{
+		LevelLow:      LevelLow,
+		LevelMedium:   LevelLow,
+		LevelHigh:     LevelMedium,
+		LevelCritical: LevelMedium,
+	} {
+		if got := rule([]Flag{{Source: SourceFinancialScoring, Level: level}}); got != want {
+			t.Errorf("%s at weight 0.5: got %s, want %s", level, got, want)
+		}
+	}
+}
+
+func TestAssess_Empty(t *testing.T) {
+	r := Assess(Input{})
+	if r.Level != LevelUnknown || len(r.Flags) != 0 || len(r.Missing) != len(allSources) {
+		t.Fatalf("unexpected report: %+v", r)
+	}
+}
+
+func TestAssess_SpecExamples(t *testing.T) {
+	srv := youscoretest.NewServer(t)
+	cl, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := t.Context()
+	code := "31095642"
+
+	analysis, err := youscore.Result(cl.GetV1ExpressAnalysisContractorCodeWithResponse(ctx, code, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sanction, err := youscore.Result(cl.GetV1SanctionsWithResponse(ctx, &youscore.GetV1SanctionsParams{ContractorCode: &code}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taxDebt, err := youscore.Result(cl.GetV1TaxDebtContractorCodeWithResponse(ctx, code))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := Assess(Input{
+		ExpressAnalysis: analysis,
+		Sanctions:       sanction,
+		TaxDebt:         taxDebt,
+	})
+	if r.Level != LevelCritical {
+		t.Fatalf("expected critical, got %s", r.Level)
+	}
+	if !slices.ContainsFunc(r.Flags, func(f Flag) bool { return f.Source == SourceSanctions && f.Level == LevelCritical }) {
+		t.Fatalf("expected a sanctions flag, got %+v", r.Flags)
+	}
+	if !slices.ContainsFunc(r.Flags, func(f Flag) bool { return f.Source == SourceTaxDebt && f.Level == LevelMedium }) {
+		t.Fatalf("expected a medium tax debt flag, got %+v", r.Flags)
+	}
+
+	// the report is deterministic
+	again := Assess(Input{
+		ExpressAnalysis: analysis,
+		Sanctions:       sanction,
+		TaxDebt:         taxDebt,
+	})
+	if !slices.Equal(r.Flags, again.Flags) {
+		t.Fatal("expected identical flags")
+	}
+}
@@ -0,0 +1,436 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ErrMissingName is the error of every check of a PersonQuery without a LastName or FirstName.
+var ErrMissingName = errors.New("youscore: LastName and FirstName are required")
+
+// PersonQuery identifies a private individual to screen. LastName and FirstName are required,
+// the other fields narrow the checks that support them.
+type PersonQuery struct {
+	LastName   string
+	FirstName  string
+	MiddleName string
+	// BirthDate is used by the RNBO sanctions, enforcement, Myrotvorets and war criminal checks.
+	BirthDate time.Time
+	// INN is used by the RNBO sanctions and enforcement checks.
+	INN string
+	// Passport is used by the RNBO sanctions check.
+	Passport string
+}
+
+// PersonCheck selects a registry checked by Poller.ScreenPerson.
+type PersonCheck string
+
+const (
+	// The async checks, started and polled by the Poller.
+	CheckRnboSanctions        PersonCheck = "rnboSanctions"
+	CheckDsfmuTerrorists      PersonCheck = "dsfmuTerrorists"
+	CheckSsuWantedAndTraitors PersonCheck = "ssuWantedAndTraitors"
+	CheckCorruptedPersons     PersonCheck = "corruptedPersons"
+	CheckFgvfoDebtors         PersonCheck = "fgvfoDebtors"
+	CheckTaxDebtors           PersonCheck = "taxDebtors"
+	CheckCec                  PersonCheck = "cec"
+	CheckCourtCasesToBeHeard  PersonCheck = "courtCasesToBeHeard"
+	CheckCourtStatusOfTheCase PersonCheck = "courtStatusOfTheCase"
+	CheckEnforcement          PersonCheck = "enforcement"
+	CheckRelatedPersons       PersonCheck = "relatedPersons"
+	// The synchronous checks.
+	CheckPeps             PersonCheck = "peps"
+	CheckMyrotvorets      PersonCheck = "myrotvorets"
+	CheckRusWarCriminals  PersonCheck = "rusWarCriminals"
+	CheckNacpWarSanctions PersonCheck = "nacpWarSanctions"
+	CheckLustratedPersons PersonCheck = "lustratedPersons"
+)
+
+// DefaultPersonChecks are run by Poller.ScreenPerson if no checks are given.
+var DefaultPersonChecks = []PersonCheck{
+	CheckRnboSanctions,
+	CheckDsfmuTerrorists,
+	CheckSsuWantedAndTraitors,
+	CheckCorruptedPersons,
+	CheckFgvfoDebtors,
+	CheckTaxDebtors,
+	CheckCec,
+	CheckCourtCasesToBeHeard,
+	CheckCourtStatusOfTheCase,
+	CheckEnforcement,
+	CheckRelatedPersons,
+	CheckPeps,
+	CheckMyrotvorets,
+	CheckRusWarCriminals,
+	CheckNacpWarSanctions,
+	CheckLustratedPersons,
+}
+
+// CheckStatus is the outcome of running a PersonCheck.
+type CheckStatus string
+
+const (
+	CheckStatusOK         CheckStatus = "ok"
+	CheckStatusInProgress CheckStatus = "inProgress" // still in progress when the Poller timed out
+	CheckStatusFailed     CheckStatus = "failed"
+)
+
+// CheckResult is the outcome of a PersonCheck.
+type CheckResult struct {
+	Status CheckStatus
+	// Err is the error of a check that is not CheckStatusOK.
+	Err error
+	// Hits is the number of records found for the person.
+	Hits int
+	// RegistryUpdateTime is the date of the registry data, zero if the response has none.
+	RegistryUpdateTime time.Time
+	// Result is the typed response of the check, e.g. *YCApiModelsResponsePeacemakerPeacemakerResultsModel
+	// for CheckMyrotvorets, nil if the check failed.
+	Result any
+}
+
+// ScreeningReport consolidates the checks of a private individual.
+type ScreeningReport struct {
+	Query PersonQuery
+	// Checks holds the outcome of every requested check.
+	Checks map[PersonCheck]CheckResult
+}
+
+// Hits returns the checks that found records for the person, sorted.
+func (r *ScreeningReport) Hits() []PersonCheck {
+	var hits []PersonCheck
+	for check, res := range r.Checks {
+		if res.Hits > 0 {
+			hits = append(hits, check)
+		}
+	}
+	slices.Sort(hits)
+	return hits
+}
+
+// Err returns the errors of all checks that are not CheckStatusOK, or nil.
+// A person is only cleared by a report without hits and without errors.
+func (r *ScreeningReport) Err() error {
+	var errs []error
+	for check, res := range r.Checks {
+		if res.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", check, res.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ScreenPerson runs the given checks (DefaultPersonChecks if none) of a private individual concurrently,
+// mapping the query onto the params of each endpoint. Async checks are started and polled,
+// synchronous checks answering 202 "Update in progress" are retried and a synchronous check answering 404
+// is a check without hits. A failing check does not affect the others.
+// Duplicate checks are run once, unknown checks are reported as CheckStatusFailed. Without a LastName or FirstName,
+// no request is made and every check fails with ErrMissingName.
+//
+// Requests go through the client of the Poller, configure it with WithRateLimit to stay within the quotas.
+func (p *Poller) ScreenPerson(ctx context.Context, q PersonQuery, checks ...PersonCheck) *ScreeningReport {
+	if len(checks) == 0 {
+		checks = DefaultPersonChecks
+	}
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	r := &ScreeningReport{
+		Query:  q,
+		Checks: make(map[PersonCheck]CheckResult),
+	}
+	middleName := optional(q.MiddleName)
+	var birthDate *string
+	if !q.BirthDate.IsZero() {
+		birthDate = optional(q.BirthDate.Format(time.DateOnly))
+	}
+
+	// all checks are validated before any is run, so that only the goroutines write to r
+	runs := make(map[PersonCheck]func() CheckResult, len(checks))
+	for _, check := range checks {
+		if _, ok := runs[check]; ok {
+			continue
+		}
+		var run func() CheckResult
+		switch check {
+		case CheckRnboSanctions:
+			run = func() CheckResult {
+				v, err := p.IndividualsRnboSanctions(ctx, &GetV1IndividualsRnboSanctionsParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+					Birthday: birthDate, INN: optional(q.INN), Passport: optional(q.Passport),
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseRnboSanctionsIndividualsRnboSanctionsSubjectsModel) (int, *time.Time) {
+					return count(v.Data), v.RegistryUpdateTime
+				})
+			}
+		case CheckDsfmuTerrorists:
+			run = func() CheckResult {
+				v, err := p.IndividualsDsfmuTerrorists(ctx, &GetV1IndividualsDsfmuTerroristsParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseIndividualsDsfmuTerroristsSearchResultsModel) (int, *time.Time) {
+					return count(v.Data), v.RegistryUpdateTime
+				})
+			}
+		case CheckSsuWantedAndTraitors:
+			run = func() CheckResult {
+				v, err := p.IndividualsSsuWantedAndTraitorPersons(ctx, &GetV1IndividualsSsuWantedAndTraitorPersonsParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseIndividualsSsuWantedAndTraitorPersonsSearchResultsModel) (int, *time.Time) {
+					return count(v.WantedData) + count(v.TraitorsData), v.RegistryUpdateTime
+				})
+			}
+		case CheckCorruptedPersons:
+			run = func() CheckResult {
+				v, err := p.CorruptedPersons(ctx, &GetV1CorruptedPersonsParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseNaturalPersonsCorruptedPersonsSearchResultsModel) (int, *time.Time) {
+					return count(v.CorruptedPersons), v.RegistryUpdateTime
+				})
+			}
+		case CheckFgvfoDebtors:
+			run = func() CheckResult {
+				v, err := p.IndividualsFgvfoDebtors(ctx, &GetV1IndividualsFgvfoDebtorsParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseIndividualsFgvfoDebtorsSearchResultsModel) (int, *time.Time) {
+					return count(v.Data), v.RegistryUpdateTime
+				})
+			}
+		case CheckTaxDebtors:
+			run = func() CheckResult {
+				v, err := p.IndividualsTaxDebtors(ctx, &GetV1IndividualsTaxDebtorsParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseIndividualsTaxDebtorsSearchResultsModel) (int, *time.Time) {
+					return count(v.IndividualsTaxDebts), v.RegistryUpdateTime
+				})
+			}
+		case CheckCec:
+			run = func() CheckResult {
+				v, err := p.IndividualsCec(ctx, &GetV1IndividualsCecParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseIndividualsCecSearchResultsModel) (int, *time.Time) {
+					if v.Data == nil {
+						return 0, v.RegistryUpdateTime
+					}
+					d := v.Data
+					return count(d.Confidants) + count(d.Deputies) + count(d.ElectoralRollCandidates) + count(d.OfficialObservers) +
+						count(d.OvkRepresentatives) + count(d.OvoAuthorizedPersons) + count(d.OvoCandidates) + count(d.RetiredDeputies), v.RegistryUpdateTime
+				})
+			}
+		case CheckCourtCasesToBeHeard:
+			run = func() CheckResult {
+				v, err := p.IndividualsCourtCasesToBeHeard(ctx, &GetV1IndividualsCourtCasesToBeHeardParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseCourtsIndividualsCourtCasesToBeHeardSearchResultsModel) (int, *time.Time) {
+					return count(v.IndividualsCourtCasesToBeHeard), v.RegistryUpdateTime
+				})
+			}
+		case CheckCourtStatusOfTheCase:
+			run = func() CheckResult {
+				v, err := p.IndividualsCourtStatusOfTheCase(ctx, &GetV1IndividualsCourtStatusOfTheCaseParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseCourtsIndividualsCourtStatusOfTheCaseSearchResultsModel) (int, *time.Time) {
+					return count(v.IndividualsCourtStatusOfTheCase), v.RegistryUpdateTime
+				})
+			}
+		case CheckEnforcement:
+			run = func() CheckResult {
+				params := &GetV1EnforcementIndividualParams{
+					Surname: q.LastName, Name: q.FirstName, MiddleName: middleName, INN: optional(q.INN),
+				}
+				if !q.BirthDate.IsZero() {
+					params.Birthday = &q.BirthDate
+				}
+				v, err := p.EnforcementIndividual(ctx, params, nil)
+				return checkResult(v, err, func(v *YCApiModelsCommonPagedResult1YCApiModelsResponseEnforcementsEnforcementIndividualInfo) (int, *time.Time) {
+					if v.TotalResults != nil {
+						return int(*v.TotalResults), nil
+					}
+					return count(v.Results), nil
+				})
+			}
+		case CheckRelatedPersons:
+			run = func() CheckResult {
+				v, err := p.IndividualsRelatedPersons(ctx, &GetV1IndividualsRelatedPersonsParams{
+					LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseIndividualsRelatedPersonsSearchResultsModel) (int, *time.Time) {
+					return count(v.Data), v.RegistryUpdateTime
+				})
+			}
+		case CheckPeps:
+			run = func() CheckResult {
+				v, err := fetchCheck(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseNationalPublicPersonsNationalPublicPersonResults, error) {
+					res, err := p.client.GetV1PepsWithResponse(ctx, &GetV1PepsParams{
+						LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+					})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseNationalPublicPersonsNationalPublicPersonResults) (int, *time.Time) {
+					return count(v.PepMatches) + count(v.RelatedToPepMatches), nil
+				})
+			}
+		case CheckMyrotvorets:
+			run = func() CheckResult {
+				v, err := fetchCheck(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponsePeacemakerPeacemakerResultsModel, error) {
+					res, err := p.client.GetV1MyrotvoretsWithResponse(ctx, &GetV1MyrotvoretsParams{
+						LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName, BirthDate: birthDate,
+					})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponsePeacemakerPeacemakerResultsModel) (int, *time.Time) {
+					return total(v.Total, v.Persons), nil
+				})
+			}
+		case CheckRusWarCriminals:
+			run = func() CheckResult {
+				v, err := fetchCheck(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseRusWarCriminalsRusWarCriminalsResultsModel, error) {
+					res, err := p.client.GetV1RuswarcriminalsWithResponse(ctx, &GetV1RuswarcriminalsParams{
+						LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName, BirthDate: birthDate,
+					})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseRusWarCriminalsRusWarCriminalsResultsModel) (int, *time.Time) {
+					return total(v.Total, v.Persons), nil
+				})
+			}
+		case CheckNacpWarSanctions:
+			run = func() CheckResult {
+				v, err := fetchCheck(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseNazkNazkSanctionsResultsModel, error) {
+					res, err := p.client.GetV1NacpwarsanctionsWithResponse(ctx, &GetV1NacpwarsanctionsParams{
+						LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName, BirthDate: birthDate,
+					})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseNazkNazkSanctionsResultsModel) (int, *time.Time) {
+					return total(v.Total, v.Persons), nil
+				})
+			}
+		case CheckLustratedPersons:
+			run = func() CheckResult {
+				v, err := fetchCheck(ctx, p, func(ctx context.Context) (int, *YCApiModelsResponseLustratedPersonsSummary, error) {
+					res, err := p.client.GetV1LustratedPersonsWithResponse(ctx, &GetV1LustratedPersonsParams{
+						LastName: q.LastName, FirstName: q.FirstName, MiddleName: middleName,
+					})
+					if err != nil {
+						return 0, nil, err
+					}
+					v, err := res.Result()
+					return res.StatusCode(), v, err
+				})
+				return checkResult(v, err, func(v *YCApiModelsResponseLustratedPersonsSummary) (int, *time.Time) {
+					return count(v.LustratedPersons) + count(v.LitigationPersons), nil
+				})
+			}
+		default:
+			r.Checks[check] = CheckResult{Status: CheckStatusFailed, Err: fmt.Errorf("unknown check %q", check)}
+			continue
+		}
+		runs[check] = run
+	}
+
+	if q.LastName == "" || q.FirstName == "" {
+		for check := range runs {
+			r.Checks[check] = CheckResult{Status: CheckStatusFailed, Err: ErrMissingName}
+		}
+		return r
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for check, run := range runs {
+		wg.Go(func() {
+			res := run()
+			mu.Lock()
+			r.Checks[check] = res
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	return r
+}
+
+// checkResult describes the outcome of a check. summarize returns the number of hits
+// and the registry update time of the response.
+func checkResult[T any](v *T, err error, summarize func(*T) (hits int, updated *time.Time)) CheckResult {
+	switch {
+	case errors.Is(err, ErrInProgress):
+		return CheckResult{Status: CheckStatusInProgress, Err: err}
+	case err != nil:
+		return CheckResult{Status: CheckStatusFailed, Err: err}
+	case v == nil:
+		return CheckResult{Status: CheckStatusOK}
+	}
+	hits, updated := summarize(v)
+	return CheckResult{
+		Status:             CheckStatusOK,
+		Hits:               hits,
+		RegistryUpdateTime: timeValue(updated),
+		Result:             v,
+	}
+}
+
+// fetchCheck fetches a synchronous check like a dossier section. A 404 means the registry
+// has no record of the person, so it yields no result and no error.
+func fetchCheck[T any](ctx context.Context, p *Poller, call func(ctx context.Context) (int, *T, error)) (*T, error) {
+	v, res := fetchSection(ctx, p, call)
+	if res.Status == SectionNotFound {
+		return nil, nil
+	}
+	return v, res.Err
+}
+
+func count[T any](s *[]T) int {
+	if s == nil {
+		return 0
+	}
+	return len(*s)
+}
+
+// total returns the total of a paged response, or the number of records if it has none.
+func total[T any](n *int32, s *[]T) int {
+	if n != nil {
+		return int(*n)
+	}
+	return count(s)
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
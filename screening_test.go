@@ -0,0 +1,150 @@
+package youscore
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPoller_ScreenPerson(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/individualsRnboSanctions": {{status: http.StatusAccepted, body: `{"resultId":"rnbo"}`}},
+		"/v1/individualsRnboSanctions/rnbo/extended": {
+			{status: http.StatusAccepted},
+			{status: http.StatusOK, body: `{"registryUpdateTime":"2025-02-01T00:00:00Z","data":[{},{}]}`},
+		},
+		"/v1/individualsTaxDebtors":     {{status: http.StatusAccepted, body: `{"resultId":"tax"}`}},
+		"/v1/individualsTaxDebtors/tax": {{status: http.StatusOK, body: `{"individualsTaxDebts":[]}`}},
+		"/v1/individualsCec":            {{status: http.StatusInternalServerError}},
+		"/v1/myrotvorets":               {{status: http.StatusOK, body: `{"total":3,"persons":[{}]}`}},
+		"/v1/peps":                      {{status: http.StatusAccepted}, {status: http.StatusOK, body: `{"pepMatches":[{}],"relatedToPepMatches":[{}]}`}},
+		// lustratedPersons is not scripted and answers 404
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl, WithPollBackoff(time.Millisecond, time.Millisecond, 2))
+	q := PersonQuery{LastName: "Шевченко", FirstName: "Тарас", BirthDate: time.Date(1814, 3, 9, 0, 0, 0, 0, time.UTC)}
+	r := p.ScreenPerson(t.Context(), q, CheckRnboSanctions, CheckTaxDebtors, CheckCec, CheckMyrotvorets, CheckPeps, CheckLustratedPersons)
+
+	rnbo := r.Checks[CheckRnboSanctions]
+	if rnbo.Status != CheckStatusOK || rnbo.Hits != 2 || rnbo.RegistryUpdateTime.Format(time.DateOnly) != "2025-02-01" {
+		t.Fatalf("unexpected rnbo check: %+v", rnbo)
+	}
+	if _, ok := rnbo.Result.(*YCApiModelsResponseRnboSanctionsIndividualsRnboSanctionsSubjectsModel); !ok {
+		t.Fatalf("unexpected rnbo result type %T", rnbo.Result)
+	}
+	if got := r.Checks[CheckTaxDebtors]; got.Status != CheckStatusOK || got.Hits != 0 {
+		t.Fatalf("unexpected tax debtors check: %+v", got)
+	}
+	if got := r.Checks[CheckCec]; got.Status != CheckStatusFailed || got.Err == nil {
+		t.Fatalf("unexpected cec check: %+v", got)
+	}
+	if got := r.Checks[CheckMyrotvorets]; got.Hits != 3 {
+		t.Fatalf("expected 3 myrotvorets hits, got %+v", got)
+	}
+	if got := r.Checks[CheckPeps]; got.Status != CheckStatusOK || got.Hits != 2 {
+		t.Fatalf("unexpected peps check: %+v", got)
+	}
+	if got := r.Checks[CheckLustratedPersons]; got.Status != CheckStatusOK || got.Err != nil || got.Hits != 0 {
+		t.Fatalf("unexpected lustrated persons check: %+v", got)
+	}
+
+	want := []PersonCheck{CheckMyrotvorets, CheckPeps, CheckRnboSanctions}
+	if got := r.Hits(); !slices.Equal(got, want) {
+		t.Fatalf("expected hits %v, got %v", want, got)
+	}
+	if err := r.Err(); err == nil || errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected only the cec error, got %v", err)
+	}
+}
+
+func TestPoller_ScreenPersonParams(t *testing.T) {
+	var query url.Values
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/ruswarcriminals": {{status: http.StatusOK, body: `{}`}},
+	})
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			query = req.URL.Query()
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl)
+	q := PersonQuery{LastName: "Шевченко", FirstName: "Тарас", MiddleName: "Григорович", BirthDate: time.Date(1814, 3, 9, 0, 0, 0, 0, time.UTC)}
+	p.ScreenPerson(t.Context(), q, CheckRusWarCriminals)
+
+	if got := doer.count("/v1/ruswarcriminals"); got != 1 {
+		t.Fatalf("expected 1 request, got %d", got)
+	}
+	for param, want := range map[string]string{
+		"LastName":   "Шевченко",
+		"FirstName":  "Тарас",
+		"MiddleName": "Григорович",
+		"BirthDate":  "1814-03-09",
+	} {
+		if got := query.Get(param); got != want {
+			t.Fatalf("expected %s=%q, got %q", param, want, got)
+		}
+	}
+}
+
+func TestPoller_ScreenPersonDuplicateAndUnknownChecks(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/peps": {{status: http.StatusOK, body: `{"pepMatches":[{}]}`}},
+	})
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPoller(cl, WithPollBackoff(time.Millisecond, time.Millisecond, 2))
+	q := PersonQuery{LastName: "Шевченко", FirstName: "Тарас"}
+	r := p.ScreenPerson(t.Context(), q, CheckPeps, "bogus", CheckPeps, "bogus")
+
+	if got := doer.count("/v1/peps"); got != 1 {
+		t.Fatalf("expected a duplicate check to run once, got %d calls", got)
+	}
+	if got := r.Checks[CheckPeps]; got.Status != CheckStatusOK || got.Hits != 1 {
+		t.Fatalf("unexpected peps check: %+v", got)
+	}
+	if got := r.Checks["bogus"]; got.Status != CheckStatusFailed || got.Err == nil {
+		t.Fatalf("unexpected unknown check: %+v", got)
+	}
+	if len(r.Checks) != 2 {
+		t.Fatalf("expected 2 checks, got %d", len(r.Checks))
+	}
+}
+
+func TestPoller_ScreenPersonMissingName(t *testing.T) {
+	doer := &fakeDoer{}
+	cl, err := NewClientWithResponses(ServerURL, WithHTTPClient(doer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewPoller(cl).ScreenPerson(t.Context(), PersonQuery{LastName: "Шевченко"})
+
+	if len(r.Checks) != len(DefaultPersonChecks) {
+		t.Fatalf("expected %d checks, got %d", len(DefaultPersonChecks), len(r.Checks))
+	}
+	for check, res := range r.Checks {
+		if res.Status != CheckStatusFailed || !errors.Is(res.Err, ErrMissingName) {
+			t.Fatalf("%s: unexpected check %+v", check, res)
+		}
+	}
+	if doer.calls != 0 {
+		t.Fatalf("expected no requests, got %d", doer.calls)
+	}
+}
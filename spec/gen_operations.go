@@ -0,0 +1,241 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	specFile   = "spec/swagger_en_processed.json"
+	outputFile = "operations.gen.go"
+)
+
+// keyCategoryByTag maps every spec tag to the API key category used by its operations.
+// Generation fails for an operation with an unmapped tag, add new tags here after updating the spec.
+var keyCategoryByTag = map[string]string{
+	"Affiliates search":                             "KeyAffiliates",
+	"Bankruptcy Information":                        "KeyDataAnalytics",
+	"Companies - Court data":                        "KeyDataAnalytics",
+	"Companies - Sanctions":                         "KeyDataAnalytics",
+	"Companies or SPs - Enforcement proceedings":    "KeyDataAnalytics",
+	"Companies – Affiliation with corporate groups": "KeyDataAnalytics",
+	"Company's financial Indicators":                "KeyDataAnalytics",
+	"Company's financial stability index FinScore":  "KeyDataAnalytics",
+	"Express Analysis Index":                        "KeyDataAnalytics",
+	"Foreign PEP Screening":                         "KeyDataAnalytics",
+	"Foreign economic activity":                     "KeyDataAnalytics",
+	"Individuals - Central Election Commission of Ukraine / Data is not updated":                        "KeyDataAnalytics",
+	"Individuals - Full Name Uniqueness Check":                                                          "KeyDataAnalytics",
+	"Individuals - Full Name check":                                                                     "KeyDataAnalytics",
+	"Individuals - Lustrated Persons / Data is not updated":                                             "KeyDataAnalytics",
+	"Individuals - Missing or wanted persons. MIA check":                                                "KeyDataAnalytics",
+	"Individuals - Passports check":                                                                     "KeyDataAnalytics",
+	"Individuals - Register of Corrupted Persons":                                                       "KeyDataAnalytics",
+	"Individuals - Russian War Criminals":                                                               "KeyDataAnalytics",
+	"Individuals - SSU registers (Security Service of Ukraine (SSU) lists)":                             "KeyDataAnalytics",
+	"Individuals - Security Service of Ukraine (SSU) lists":                                             "KeyDataAnalytics",
+	"Individuals - Terrorists":                                                                          "KeyDataAnalytics",
+	"Individuals - War and Sanctions project (supported by the MFA and the NACP) / Data is not updated": "KeyDataAnalytics",
+	"Individuals - suspects in the main case of \"24 february\"":                                        "KeyDataAnalytics",
+	"Individuals - «Myrotvorets» Center":                                                                "KeyDataAnalytics",
+	"Licenses / Data is not updated":                                                                    "KeyDataAnalytics",
+	"Market Power Index MarketScore":                                                                    "KeyDataAnalytics",
+	"Media reputation":                                                                                  "KeyDataAnalytics",
+	"Natural Persons - Corrupted Persons Registry":                                                      "KeyDataAnalytics",
+	"Number of employees":                                                                               "KeyDataAnalytics",
+	"PDF report of companies or sole proprietors check":                                                 "KeyPDFLegalEntities",
+	"PDF report of private individual check":                                                            "KeyPDFIndividuals",
+	"PEP Screening":                                                                                     "KeyDataAnalytics",
+	"PEPs affiliated to the company":                                                                    "KeyDataAnalytics",
+	"Private individual - Court cases":                                                                  "KeyDataAnalytics",
+	"Private individual - Debtors":                                                                      "KeyDataAnalytics",
+	"Private individual - Enforcement proceedings":                                                      "KeyDataAnalytics",
+	"Private individual - Related companies and SPs":                                                    "KeyDataAnalytics",
+	"Private individual - Related companies by Tin or Passport":                                         "KeyDataAnalytics",
+	"Private individual - Sanctions":                                                                    "KeyDataAnalytics",
+	"Private individual – Affiliation with corporate groups":                                            "KeyDataAnalytics",
+	"Private individuals - Related companies and SPs":                                                   "KeyDataAnalytics",
+	"Private individuals - Related companies by TIN and/or Passport":                                    "KeyDataAnalytics",
+	"Real Estate [Available to identified users]":                                                       "KeyDataAnalytics",
+	"Registration data":                                                                                 "KeyDataAnalytics",
+	"SETAM":                                                                                             "KeyDataAnalytics",
+	"State Register of Encumbrances over the Movable Property":                                          "KeyDataAnalytics",
+	"State Register of Encumbrances over the Movable Property (DRORM)":                                  "KeyDataAnalytics",
+	"Tax data": "KeyDataAnalytics",
+	"Tenders":  "KeyDataAnalytics",
+	"Utility":  "KeyDataAnalytics",
+	"Vehicles": "KeyDataAnalytics",
+}
+
+// uncacheableTags lists spec tags whose operations must never be cached.
+var uncacheableTags = map[string]bool{
+	"Utility": true, // rate limits
+}
+
+var (
+	// summaries carry e.g. //【Information type "DATA", Transaction "+"】, with varying case and quotes
+	infoTypeRe    = regexp.MustCompile(`(?i)Information\s+type\s+["“”]([A-Z]+)["“”]`)
+	transactionRe = regexp.MustCompile(`(?i)Transaction\s+["“”]([+-])["“”]`)
+)
+
+var apiTypeByInfoType = map[string]string{
+	"DATA":      "APITypeData",
+	"ANALYTICS": "APITypeAnalysis",
+	"CUSTOM":    "APITypeCustom",
+}
+
+type spec struct {
+	Paths map[string]map[string]struct {
+		OperationID string                     `json:"operationId"`
+		Summary     string                     `json:"summary"`
+		Tags        []string                   `json:"tags"`
+		Responses   map[string]json.RawMessage `json:"responses"`
+	} `json:"paths"`
+}
+
+type operation struct {
+	Name        string
+	Method      string
+	Path        string
+	InfoType    string
+	Transaction bool
+	Key         string
+	Async       string
+	Cacheable   bool
+}
+
+func main() {
+	data, err := os.ReadFile(specFile)
+	if err != nil {
+		log.Fatal("read spec: ", err)
+	}
+
+	var s spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		log.Fatal("unmarshal spec: ", err)
+	}
+
+	var ops []operation
+	for path, methods := range s.Paths {
+		for method, op := range methods {
+			name := op.OperationID
+			if name == "" {
+				name = operationName(method, path)
+			}
+
+			if len(op.Tags) == 0 {
+				log.Fatalf("%s %s: no tags", method, path)
+			}
+			key := ""
+			cacheable := true
+			for _, tag := range op.Tags {
+				k, ok := keyCategoryByTag[tag]
+				if !ok {
+					log.Fatalf("%s %s: no key category for tag %q", method, path, tag)
+				}
+				if key != "" && key != k {
+					log.Fatalf("%s %s: conflicting key categories %s and %s", method, path, key, k)
+				}
+				key = k
+				if uncacheableTags[tag] {
+					cacheable = false
+				}
+			}
+
+			// operations without an information type (dictionaries, rate limits) are free DATA calls
+			infoType := "APITypeData"
+			if m := infoTypeRe.FindStringSubmatch(op.Summary); m != nil {
+				t, ok := apiTypeByInfoType[strings.ToUpper(m[1])]
+				if !ok {
+					log.Fatalf("%s %s: unknown information type %q", method, path, m[1])
+				}
+				infoType = t
+			}
+
+			// operations with an information type but no transaction marker (e.g. SETAM) are billed
+			transaction := infoTypeRe.MatchString(op.Summary)
+			if m := transactionRe.FindStringSubmatch(op.Summary); m != nil {
+				transaction = m[1] == "+"
+			}
+
+			_, has200 := op.Responses["200"]
+			_, has202 := op.Responses["202"]
+			async := "AsyncNone"
+			switch {
+			case strings.Contains(path, "{resultId}"), strings.Contains(path, "{journalId}"), strings.Contains(path, "/result/"):
+				async = "AsyncResult"
+			case has202 && !has200, strings.Contains(path, "/start/"):
+				async = "AsyncStart"
+			}
+
+			// starting an async job must always reach the API
+			if async == "AsyncStart" {
+				cacheable = false
+			}
+
+			ops = append(ops, operation{
+				Name:        name,
+				Method:      strings.ToUpper(method),
+				Path:        path,
+				InfoType:    infoType,
+				Transaction: transaction,
+				Key:         key,
+				Async:       async,
+				Cacheable:   cacheable,
+			})
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Name < ops[j].Name })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by spec/gen_operations.go from %s DO NOT EDIT.\n\n", specFile)
+	fmt.Fprintf(&buf, "package youscore\n\n")
+	fmt.Fprintf(&buf, "// operations lists every operation in the spec.\n")
+	fmt.Fprintf(&buf, "var operations = []Operation{\n")
+	for _, op := range ops {
+		fmt.Fprintf(&buf, "\t{Name: %q, Method: %q, Path: %q, InfoType: %s, Transaction: %t, Key: %s, Async: %s, Cacheable: %t},\n",
+			op.Name, op.Method, op.Path, op.InfoType, op.Transaction, op.Key, op.Async, op.Cacheable)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal("format: ", err)
+	}
+	if err := os.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatal("write: ", err)
+	}
+	log.Printf("wrote %d operations to %s", len(ops), outputFile)
+}
+
+// operationName derives the operation name the same way oapi-codegen does
+// for operations without an operationId, e.g. GET /v1/usr/{contractorCode} -> GetV1UsrContractorCode.
+func operationName(method, path string) string {
+	var b strings.Builder
+	b.WriteString(upperFirst(strings.ToLower(method)))
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		b.WriteString(upperFirst(part))
+	}
+	return b.String()
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,82 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	clientFile = "client.gen.go"
+	outputFile = "results.gen.go"
+)
+
+type result struct {
+	Response string
+	Type     string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, clientFile, nil, 0)
+	if err != nil {
+		log.Fatal("parse client: ", err)
+	}
+
+	// find every generated *Response struct with a JSON200 field
+	var results []result
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || !strings.HasSuffix(ts.Name.Name, "Response") {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				if len(field.Names) == 1 && field.Names[0].Name == "JSON200" {
+					var typ bytes.Buffer
+					if err := format.Node(&typ, fset, field.Type); err != nil {
+						log.Fatal("format type: ", err)
+					}
+					results = append(results, result{Response: ts.Name.Name, Type: typ.String()})
+				}
+			}
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Response < results[j].Response })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by spec/gen_results.go from %s DO NOT EDIT.\n\n", clientFile)
+	fmt.Fprintf(&buf, "package youscore\n\n")
+	for _, r := range results {
+		fmt.Fprintf(&buf, "// Result returns the JSON200 payload, or an *APIError if the status is not 200.\n")
+		fmt.Fprintf(&buf, "func (r *%s) Result() (%s, error) {\n", r.Response, r.Type)
+		fmt.Fprintf(&buf, "\tif err := CheckResponse(r.HTTPResponse, r.Body); err != nil {\n")
+		fmt.Fprintf(&buf, "\t\treturn nil, err\n")
+		fmt.Fprintf(&buf, "\t}\n")
+		fmt.Fprintf(&buf, "\treturn r.JSON200, nil\n")
+		fmt.Fprintf(&buf, "}\n\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal("format: ", err)
+	}
+	if err := os.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatal("write: ", err)
+	}
+	log.Printf("wrote %d result helpers to %s", len(results), outputFile)
+}
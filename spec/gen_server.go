@@ -0,0 +1,91 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	clientFile = "client.gen.go"
+	outputFile = "youscoretest/server.gen.go"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, clientFile, nil, 0)
+	if err != nil {
+		log.Fatal("parse client: ", err)
+	}
+
+	var iface *ast.InterfaceType
+	ast.Inspect(f, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok && ts.Name.Name == "ServerInterface" {
+			iface, _ = ts.Type.(*ast.InterfaceType)
+		}
+		return iface == nil
+	})
+	if iface == nil {
+		log.Fatal("ServerInterface not found in ", clientFile)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by spec/gen_server.go from %s DO NOT EDIT.\n\n", clientFile)
+	fmt.Fprintf(&buf, "package youscoretest\n\n")
+	fmt.Fprintf(&buf, "import (\n\t\"github.com/fritzkeyzer/goyouscore\"\n\t\"github.com/labstack/echo/v4\"\n)\n\n")
+	fmt.Fprintf(&buf, "var _ youscore.ServerInterface = (*Server)(nil)\n\n")
+
+	n := 0
+	for _, m := range iface.Methods.List {
+		fn, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) != 1 {
+			continue
+		}
+		name := m.Names[0].Name
+
+		// the typed parameters are not needed, the handler reads them from the echo.Context
+		params := []string{"ctx echo.Context"}
+		for _, p := range fn.Params.List[1:] {
+			params = append(params, "_ "+qualify(fset, p.Type))
+		}
+		fmt.Fprintf(&buf, "func (s *Server) %s(%s) error {\n", name, strings.Join(params, ", "))
+		fmt.Fprintf(&buf, "\treturn s.handle(ctx, %q)\n", name)
+		fmt.Fprintf(&buf, "}\n\n")
+		n++
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal("format: ", err)
+	}
+	if err := os.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatal("write: ", err)
+	}
+	log.Printf("wrote %d handlers to %s", n, outputFile)
+}
+
+// qualify formats a type expression of the youscore package for use in another package.
+func qualify(fset *token.FileSet, expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			return "youscore." + e.Name
+		}
+		return e.Name
+	case *ast.StarExpr:
+		return "*" + qualify(fset, e.X)
+	}
+	var typ bytes.Buffer
+	if err := format.Node(&typ, fset, expr); err != nil {
+		log.Fatal("format type: ", err)
+	}
+	return typ.String()
+}
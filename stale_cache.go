@@ -0,0 +1,117 @@
+package youscore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// StaleHeader is set to "true" on responses that WithCache served from a stale cache entry.
+// The standard Age header holds the age of the entry in seconds.
+const StaleHeader = "X-Youscore-Stale"
+
+// revalidateTimeout bounds a background revalidation, which outlives the request that started it.
+const revalidateTimeout = time.Minute
+
+// StalePolicy configures how WithCache treats cache entries older than SoftTTL.
+// Stale entries are only served if they are still in the cache, so the Cache TTL must exceed SoftTTL.
+type StalePolicy struct {
+	// SoftTTL is the age after which a cached response is stale and gets refreshed.
+	// Zero disables stale handling.
+	SoftTTL time.Duration
+	// WhileRevalidate serves stale responses immediately and refreshes them in the background.
+	// Otherwise stale responses are refreshed before responding.
+	WhileRevalidate bool
+	// OnError serves the stale response if the refresh fails with a network error, a 5xx or a 202 "Update in progress".
+	OnError bool
+}
+
+// WithStalePolicy enables stale-while-revalidate and serve-stale-on-error, see StalePolicy.
+// Use StaleInfo to check whether a response is stale.
+func WithStalePolicy(policy StalePolicy) CacheOption {
+	return func(d *cachingDoer) {
+		d.stale = policy
+	}
+}
+
+// WithRevalidateHook sets a function that is called when a background revalidation
+// (StalePolicy.WhileRevalidate) has finished, with the sanitized URL of the request and the error
+// of the refresh, if any. A refresh answered with a 5xx or a 202 "Update in progress" reports an *APIError.
+func WithRevalidateHook(fn func(rawURL string, err error)) CacheOption {
+	return func(d *cachingDoer) {
+		d.onRevalidate = fn
+	}
+}
+
+// StaleInfo reports whether a response was served from a stale cache entry, and the age of the entry.
+// The age is zero if unknown (e.g. not served from the cache).
+func StaleInfo(resp *http.Response) (stale bool, age time.Duration) {
+	if resp == nil {
+		return false, 0
+	}
+	if secs, err := strconv.Atoi(resp.Header.Get("Age")); err == nil {
+		age = time.Duration(secs) * time.Second
+	}
+	return resp.Header.Get(StaleHeader) == "true", age
+}
+
+func (d *cachingDoer) isStale(cached CachedResponse) bool {
+	if d.stale.SoftTTL <= 0 || cached.Metadata.FetchedAt.IsZero() {
+		return false
+	}
+	return time.Since(cached.Metadata.FetchedAt) > d.stale.SoftTTL
+}
+
+// revalidate refreshes a cache entry in the background, at most once at a time per key.
+func (d *cachingDoer) revalidate(req *http.Request, body []byte, rawURL string, key string) {
+	if _, loaded := d.refreshing.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	// detach from the caller: its context may be cancelled once it has its response,
+	// and its usage record must not be touched concurrently
+	ctx := context.WithValue(context.WithoutCancel(req.Context()), callRecordCtxKey{}, (*callRecord)(nil))
+	ctx, cancel := context.WithTimeout(ctx, revalidateTimeout)
+	refresh := req.Clone(ctx)
+	if body != nil {
+		refresh.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	go func() {
+		err := d.refresh(refresh, rawURL, key)
+		cancel()
+		d.refreshing.Delete(key)
+		if d.onRevalidate != nil {
+			d.onRevalidate(rawURL, err)
+		}
+	}()
+}
+
+// refresh fetches and stores a response in the background.
+// A failed refresh keeps the stale entry, it is retried by the next request.
+func (d *cachingDoer) refresh(req *http.Request, rawURL string, key string) error {
+	resp, err := d.inner.Do(req)
+	if err != nil {
+		return err
+	}
+	if resp, err = d.store(req, rawURL, key, resp); err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if upstreamFailed(req.Context(), resp, nil) {
+		return &APIError{StatusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+// upstreamFailed reports whether a refresh failed in a way that a stale response may cover for.
+// Cancellation by the caller is not covered.
+func upstreamFailed(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusAccepted
+}
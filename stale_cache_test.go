@@ -0,0 +1,98 @@
+package youscore
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithStalePolicy_OnError(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {{status: http.StatusServiceUnavailable}},
+	})
+	cache := newMapCache()
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithCache(cache, WithStalePolicy(StalePolicy{SoftTTL: time.Hour, OnError: true})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an entry older than the soft TTL
+	req, err := NewGetV1UsrContractorCodeRequest(ServerURL, "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _, err := cacheKey(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Set("", key, CachedResponse{StatusCode: http.StatusOK, Body: []byte(`{}`), Metadata: CacheMetadata{FetchedAt: time.Now().Add(-2 * time.Hour)}})
+
+	res, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusOK {
+		t.Fatalf("expected the stale 200, got %d", res.StatusCode())
+	}
+	stale, age := StaleInfo(res.HTTPResponse)
+	if !stale || age < 2*time.Hour {
+		t.Fatalf("expected a stale response of 2h, got %t, %v", stale, age)
+	}
+	if got := doer.count("/v1/usr/08215600"); got != 1 {
+		t.Fatalf("expected a refresh attempt, got %d calls", got)
+	}
+}
+
+func TestWithStalePolicy_WhileRevalidate(t *testing.T) {
+	doer := newScriptedDoer(map[string][]scriptedResponse{
+		"/v1/usr/08215600": {{status: http.StatusOK, body: `{"fresh":true}`}},
+	})
+	cache := newMapCache()
+	revalidated := make(chan error, 1)
+	cl, err := NewClientWithResponses(ServerURL,
+		WithHTTPClient(doer),
+		WithCache(cache,
+			WithStalePolicy(StalePolicy{SoftTTL: time.Hour, WhileRevalidate: true}),
+			WithRevalidateHook(func(_ string, err error) { revalidated <- err }),
+		),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := NewGetV1UsrContractorCodeRequest(ServerURL, "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _, err := cacheKey(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Set("", key, CachedResponse{StatusCode: http.StatusOK, Body: []byte(`{}`), Metadata: CacheMetadata{FetchedAt: time.Now().Add(-2 * time.Hour)}})
+
+	res, err := cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stale, _ := StaleInfo(res.HTTPResponse); !stale || string(res.Body) != `{}` {
+		t.Fatalf("expected the stale body, got %t, %s", stale, res.Body)
+	}
+
+	if err := <-revalidated; err != nil {
+		t.Fatal(err)
+	}
+
+	res, err = cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stale, _ := StaleInfo(res.HTTPResponse); stale || string(res.Body) != `{"fresh":true}` {
+		t.Fatalf("expected the refreshed body, got %t, %s", stale, res.Body)
+	}
+	if got := doer.count("/v1/usr/08215600"); got != 1 {
+		t.Fatalf("expected 1 background refresh, got %d calls", got)
+	}
+}
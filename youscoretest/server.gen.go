@@ -0,0 +1,490 @@
+// Code generated by spec/gen_server.go from client.gen.go DO NOT EDIT.
+
+package youscoretest
+
+import (
+	"github.com/fritzkeyzer/goyouscore"
+	"github.com/labstack/echo/v4"
+)
+
+var _ youscore.ServerInterface = (*Server)(nil)
+
+func (s *Server) GetV1IndividualsCourtStatusOfTheCase(ctx echo.Context, _ youscore.GetV1IndividualsCourtStatusOfTheCaseParams) error {
+	return s.handle(ctx, "GetV1IndividualsCourtStatusOfTheCase")
+}
+
+func (s *Server) GetV1IndividualsCourtStatusOfTheCaseResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsCourtStatusOfTheCaseResultId")
+}
+
+func (s *Server) PostV1AffiliatesQuery(ctx echo.Context) error {
+	return s.handle(ctx, "PostV1AffiliatesQuery")
+}
+
+func (s *Server) GetV1AffiliatesResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1AffiliatesResultId")
+}
+
+func (s *Server) GetV1BusinessPartnerContractorCode(ctx echo.Context, _ string, _ youscore.GetV1BusinessPartnerContractorCodeParams) error {
+	return s.handle(ctx, "GetV1BusinessPartnerContractorCode")
+}
+
+func (s *Server) GetV1CompanyPersons(ctx echo.Context, _ youscore.GetV1CompanyPersonsParams) error {
+	return s.handle(ctx, "GetV1CompanyPersons")
+}
+
+func (s *Server) GetV1CompanyPersonsRelations(ctx echo.Context, _ youscore.GetV1CompanyPersonsRelationsParams) error {
+	return s.handle(ctx, "GetV1CompanyPersonsRelations")
+}
+
+func (s *Server) GetV1CompanyPersonsId(ctx echo.Context, _ int32) error {
+	return s.handle(ctx, "GetV1CompanyPersonsId")
+}
+
+func (s *Server) GetV1ContractorsPdfFileContractorCode(ctx echo.Context, _ string, _ youscore.GetV1ContractorsPdfFileContractorCodeParams) error {
+	return s.handle(ctx, "GetV1ContractorsPdfFileContractorCode")
+}
+
+func (s *Server) GetV1CorruptedPersons(ctx echo.Context, _ youscore.GetV1CorruptedPersonsParams) error {
+	return s.handle(ctx, "GetV1CorruptedPersons")
+}
+
+func (s *Server) GetV1CorruptedPersonsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1CorruptedPersonsResultId")
+}
+
+func (s *Server) GetV1CourtContractorCode(ctx echo.Context, _ string, _ youscore.GetV1CourtContractorCodeParams) error {
+	return s.handle(ctx, "GetV1CourtContractorCode")
+}
+
+func (s *Server) GetV1CourtCaseGroupContractorCode(ctx echo.Context, _ string, _ youscore.GetV1CourtCaseGroupContractorCodeParams) error {
+	return s.handle(ctx, "GetV1CourtCaseGroupContractorCode")
+}
+
+func (s *Server) GetV1EncumbrancesDetailsEncumbranceId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1EncumbrancesDetailsEncumbranceId")
+}
+
+func (s *Server) GetV1EncumbrancesResultResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1EncumbrancesResultResultId")
+}
+
+func (s *Server) GetV1EncumbrancesResultdetailsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1EncumbrancesResultdetailsResultId")
+}
+
+func (s *Server) GetV1EncumbrancesContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1EncumbrancesContractorCode")
+}
+
+func (s *Server) GetV1EnforcementContractorCode(ctx echo.Context, _ string, _ youscore.GetV1EnforcementContractorCodeParams) error {
+	return s.handle(ctx, "GetV1EnforcementContractorCode")
+}
+
+func (s *Server) GetV1EnforcementIndividual(ctx echo.Context, _ youscore.GetV1EnforcementIndividualParams) error {
+	return s.handle(ctx, "GetV1EnforcementIndividual")
+}
+
+func (s *Server) GetV1EnforcementIndividualResultId(ctx echo.Context, _ string, _ youscore.GetV1EnforcementIndividualResultIdParams) error {
+	return s.handle(ctx, "GetV1EnforcementIndividualResultId")
+}
+
+func (s *Server) GetV1ExpressAnalysisAggressorsContractorCode(ctx echo.Context, _ string, _ youscore.GetV1ExpressAnalysisAggressorsContractorCodeParams) error {
+	return s.handle(ctx, "GetV1ExpressAnalysisAggressorsContractorCode")
+}
+
+func (s *Server) GetV1ExpressAnalysisFinmonContractorCode(ctx echo.Context, _ string, _ youscore.GetV1ExpressAnalysisFinmonContractorCodeParams) error {
+	return s.handle(ctx, "GetV1ExpressAnalysisFinmonContractorCode")
+}
+
+func (s *Server) GetV1ExpressAnalysisContractorCode(ctx echo.Context, _ string, _ youscore.GetV1ExpressAnalysisContractorCodeParams) error {
+	return s.handle(ctx, "GetV1ExpressAnalysisContractorCode")
+}
+
+func (s *Server) GetV1ExternalEconomiesContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1ExternalEconomiesContractorCode")
+}
+
+func (s *Server) GetV1ExternalEconomiesContractorCodeYearsYear(ctx echo.Context, _ string, _ string) error {
+	return s.handle(ctx, "GetV1ExternalEconomiesContractorCodeYearsYear")
+}
+
+func (s *Server) GetV1Fig(ctx echo.Context, _ youscore.GetV1FigParams) error {
+	return s.handle(ctx, "GetV1Fig")
+}
+
+func (s *Server) GetV1FigId(ctx echo.Context, _ int32) error {
+	return s.handle(ctx, "GetV1FigId")
+}
+
+func (s *Server) GetV1FinancialIndicatorsContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1FinancialIndicatorsContractorCode")
+}
+
+func (s *Server) GetV1FinancialIndicatorsContractorCodeYearsYear(ctx echo.Context, _ string, _ int32, _ youscore.GetV1FinancialIndicatorsContractorCodeYearsYearParams) error {
+	return s.handle(ctx, "GetV1FinancialIndicatorsContractorCodeYearsYear")
+}
+
+func (s *Server) GetV1FinancialScoringContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1FinancialScoringContractorCode")
+}
+
+func (s *Server) GetV1FinancialScoringContractorCodeYearsYear(ctx echo.Context, _ string, _ int32) error {
+	return s.handle(ctx, "GetV1FinancialScoringContractorCodeYearsYear")
+}
+
+func (s *Server) GetV1Generalprosecutor24febsuspect(ctx echo.Context, _ youscore.GetV1Generalprosecutor24febsuspectParams) error {
+	return s.handle(ctx, "GetV1Generalprosecutor24febsuspect")
+}
+
+func (s *Server) GetV1HistoryContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1HistoryContractorCode")
+}
+
+func (s *Server) GetV1IndividualsCec(ctx echo.Context, _ youscore.GetV1IndividualsCecParams) error {
+	return s.handle(ctx, "GetV1IndividualsCec")
+}
+
+func (s *Server) GetV1IndividualsCecResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsCecResultId")
+}
+
+func (s *Server) GetV1IndividualsCourtCasesToBeHeard(ctx echo.Context, _ youscore.GetV1IndividualsCourtCasesToBeHeardParams) error {
+	return s.handle(ctx, "GetV1IndividualsCourtCasesToBeHeard")
+}
+
+func (s *Server) GetV1IndividualsCourtCasesToBeHeardResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsCourtCasesToBeHeardResultId")
+}
+
+func (s *Server) GetV1IndividualsDsfmuTerrorists(ctx echo.Context, _ youscore.GetV1IndividualsDsfmuTerroristsParams) error {
+	return s.handle(ctx, "GetV1IndividualsDsfmuTerrorists")
+}
+
+func (s *Server) GetV1IndividualsDsfmuTerroristsRecordTypes(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsDsfmuTerroristsRecordTypes")
+}
+
+func (s *Server) GetV1IndividualsDsfmuTerroristsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsDsfmuTerroristsResultId")
+}
+
+func (s *Server) GetV1IndividualsFgvfoDebtors(ctx echo.Context, _ youscore.GetV1IndividualsFgvfoDebtorsParams) error {
+	return s.handle(ctx, "GetV1IndividualsFgvfoDebtors")
+}
+
+func (s *Server) GetV1IndividualsFgvfoDebtorsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsFgvfoDebtorsResultId")
+}
+
+func (s *Server) GetV1IndividualsFigCompanies(ctx echo.Context, _ youscore.GetV1IndividualsFigCompaniesParams) error {
+	return s.handle(ctx, "GetV1IndividualsFigCompanies")
+}
+
+func (s *Server) GetV1IndividualsFigCompaniesRelationTypes(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsFigCompaniesRelationTypes")
+}
+
+func (s *Server) GetV1IndividualsFigCompaniesResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsFigCompaniesResultId")
+}
+
+func (s *Server) GetV1IndividualsFullNameInfo(ctx echo.Context, _ youscore.GetV1IndividualsFullNameInfoParams) error {
+	return s.handle(ctx, "GetV1IndividualsFullNameInfo")
+}
+
+func (s *Server) GetV1IndividualsFullNameInfoCoincidenceStatuses(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsFullNameInfoCoincidenceStatuses")
+}
+
+func (s *Server) GetV1IndividualsFullNameInfoResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsFullNameInfoResultId")
+}
+
+func (s *Server) GetV1IndividualsGlobalSanctionsLists(ctx echo.Context, _ youscore.GetV1IndividualsGlobalSanctionsListsParams) error {
+	return s.handle(ctx, "GetV1IndividualsGlobalSanctionsLists")
+}
+
+func (s *Server) GetV1IndividualsPdfReports(ctx echo.Context, _ youscore.GetV1IndividualsPdfReportsParams) error {
+	return s.handle(ctx, "GetV1IndividualsPdfReports")
+}
+
+func (s *Server) GetV1IndividualsPdfReportsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsPdfReportsResultId")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersons(ctx echo.Context, _ youscore.GetV1IndividualsRelatedPersonsParams) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersons")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsContractorStatuses(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsContractorStatuses")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsContractorTypes(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsContractorTypes")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsRelationStatuses(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsRelationStatuses")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsRelationTypes(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsRelationTypes")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsResultId")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsByCode(ctx echo.Context, _ youscore.GetV1IndividualsRelatedPersonsByCodeParams) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsByCode")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsByCodeAssotiationTypes(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsByCodeAssotiationTypes")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsByCodeContractorStatuses(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsByCodeContractorStatuses")
+}
+
+func (s *Server) GetV1IndividualsRelatedPersonsByCodeResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsRelatedPersonsByCodeResultId")
+}
+
+func (s *Server) GetV1IndividualsRnboSanctions(ctx echo.Context, _ youscore.GetV1IndividualsRnboSanctionsParams) error {
+	return s.handle(ctx, "GetV1IndividualsRnboSanctions")
+}
+
+func (s *Server) GetV1IndividualsRnboSanctionsResultIdExtended(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsRnboSanctionsResultIdExtended")
+}
+
+func (s *Server) GetV1IndividualsSsuWantedAndTraitorPersons(ctx echo.Context, _ youscore.GetV1IndividualsSsuWantedAndTraitorPersonsParams) error {
+	return s.handle(ctx, "GetV1IndividualsSsuWantedAndTraitorPersons")
+}
+
+func (s *Server) GetV1IndividualsSsuWantedAndTraitorPersonsGenders(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1IndividualsSsuWantedAndTraitorPersonsGenders")
+}
+
+func (s *Server) GetV1IndividualsSsuWantedAndTraitorPersonsPhotosPhotoUrl(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsSsuWantedAndTraitorPersonsPhotosPhotoUrl")
+}
+
+func (s *Server) GetV1IndividualsSsuWantedAndTraitorPersonsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsSsuWantedAndTraitorPersonsResultId")
+}
+
+func (s *Server) GetV1IndividualsTaxDebtors(ctx echo.Context, _ youscore.GetV1IndividualsTaxDebtorsParams) error {
+	return s.handle(ctx, "GetV1IndividualsTaxDebtors")
+}
+
+func (s *Server) GetV1IndividualsTaxDebtorsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1IndividualsTaxDebtorsResultId")
+}
+
+func (s *Server) GetV1InvestigationDetails(ctx echo.Context, _ youscore.GetV1InvestigationDetailsParams) error {
+	return s.handle(ctx, "GetV1InvestigationDetails")
+}
+
+func (s *Server) GetV1InvestigationsLegal(ctx echo.Context, _ youscore.GetV1InvestigationsLegalParams) error {
+	return s.handle(ctx, "GetV1InvestigationsLegal")
+}
+
+func (s *Server) GetV1InvestigationsNatural(ctx echo.Context, _ youscore.GetV1InvestigationsNaturalParams) error {
+	return s.handle(ctx, "GetV1InvestigationsNatural")
+}
+
+func (s *Server) GetV1Licenses(ctx echo.Context, _ youscore.GetV1LicensesParams) error {
+	return s.handle(ctx, "GetV1Licenses")
+}
+
+func (s *Server) GetV1LicensesRegistersList(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1LicensesRegistersList")
+}
+
+func (s *Server) GetV1LicensesRelevance(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1LicensesRelevance")
+}
+
+func (s *Server) GetV1LicensesLicenseCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1LicensesLicenseCode")
+}
+
+func (s *Server) GetV1LustratedPersons(ctx echo.Context, _ youscore.GetV1LustratedPersonsParams) error {
+	return s.handle(ctx, "GetV1LustratedPersons")
+}
+
+func (s *Server) GetV1MarketScoringContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1MarketScoringContractorCode")
+}
+
+func (s *Server) GetV1MarketScoringContractorCodeYearsYear(ctx echo.Context, _ string, _ int32) error {
+	return s.handle(ctx, "GetV1MarketScoringContractorCodeYearsYear")
+}
+
+func (s *Server) GetV1Myrotvorets(ctx echo.Context, _ youscore.GetV1MyrotvoretsParams) error {
+	return s.handle(ctx, "GetV1Myrotvorets")
+}
+
+func (s *Server) GetV1Nacpwarsanctions(ctx echo.Context, _ youscore.GetV1NacpwarsanctionsParams) error {
+	return s.handle(ctx, "GetV1Nacpwarsanctions")
+}
+
+func (s *Server) GetV1NonProfitCompaniesContractorCode(ctx echo.Context, _ string, _ youscore.GetV1NonProfitCompaniesContractorCodeParams) error {
+	return s.handle(ctx, "GetV1NonProfitCompaniesContractorCode")
+}
+
+func (s *Server) GetV1Passports(ctx echo.Context, _ youscore.GetV1PassportsParams) error {
+	return s.handle(ctx, "GetV1Passports")
+}
+
+func (s *Server) GetV1Peps(ctx echo.Context, _ youscore.GetV1PepsParams) error {
+	return s.handle(ctx, "GetV1Peps")
+}
+
+func (s *Server) GetV1PepsExtendedInfo(ctx echo.Context, _ youscore.GetV1PepsExtendedInfoParams) error {
+	return s.handle(ctx, "GetV1PepsExtendedInfo")
+}
+
+func (s *Server) GetV1PepsRelated(ctx echo.Context, _ youscore.GetV1PepsRelatedParams) error {
+	return s.handle(ctx, "GetV1PepsRelated")
+}
+
+func (s *Server) GetV1Pepsforeign(ctx echo.Context, _ youscore.GetV1PepsforeignParams) error {
+	return s.handle(ctx, "GetV1Pepsforeign")
+}
+
+func (s *Server) GetV1PepsforeignRelated(ctx echo.Context, _ youscore.GetV1PepsforeignRelatedParams) error {
+	return s.handle(ctx, "GetV1PepsforeignRelated")
+}
+
+func (s *Server) GetV1RateLimits(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1RateLimits")
+}
+
+func (s *Server) GetV1RealEstateDataTypes(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1RealEstateDataTypes")
+}
+
+func (s *Server) GetV1RealEstateDetailsLandId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1RealEstateDetailsLandId")
+}
+
+func (s *Server) GetV1RealEstateResultResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1RealEstateResultResultId")
+}
+
+func (s *Server) GetV1RealEstateResultdetailsResultId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1RealEstateResultdetailsResultId")
+}
+
+func (s *Server) GetV1RealEstateContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1RealEstateContractorCode")
+}
+
+func (s *Server) GetV1Ruswarcriminals(ctx echo.Context, _ youscore.GetV1RuswarcriminalsParams) error {
+	return s.handle(ctx, "GetV1Ruswarcriminals")
+}
+
+func (s *Server) GetV1Sanctions(ctx echo.Context, _ youscore.GetV1SanctionsParams) error {
+	return s.handle(ctx, "GetV1Sanctions")
+}
+
+func (s *Server) GetV1Secou(ctx echo.Context, _ youscore.GetV1SecouParams) error {
+	return s.handle(ctx, "GetV1Secou")
+}
+
+func (s *Server) GetV1SetamAuctions(ctx echo.Context, _ youscore.GetV1SetamAuctionsParams) error {
+	return s.handle(ctx, "GetV1SetamAuctions")
+}
+
+func (s *Server) GetV1SetamAuctionsProceedingsNumber(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1SetamAuctionsProceedingsNumber")
+}
+
+func (s *Server) GetV1ShareholdersContractorCode(ctx echo.Context, _ string, _ youscore.GetV1ShareholdersContractorCodeParams) error {
+	return s.handle(ctx, "GetV1ShareholdersContractorCode")
+}
+
+func (s *Server) GetV1SingleTaxContractorCode(ctx echo.Context, _ string, _ youscore.GetV1SingleTaxContractorCodeParams) error {
+	return s.handle(ctx, "GetV1SingleTaxContractorCode")
+}
+
+func (s *Server) GetV1StaffContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1StaffContractorCode")
+}
+
+func (s *Server) GetV1TaxDebtContractorCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1TaxDebtContractorCode")
+}
+
+func (s *Server) GetV1TendersContractStatuses(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1TendersContractStatuses")
+}
+
+func (s *Server) GetV1TendersContractsTenderId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1TendersContractsTenderId")
+}
+
+func (s *Server) GetV1TendersProcedureTypes(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1TendersProcedureTypes")
+}
+
+func (s *Server) GetV1TendersProcedures(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1TendersProcedures")
+}
+
+func (s *Server) GetV1TendersRisksStartTenderId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1TendersRisksStartTenderId")
+}
+
+func (s *Server) GetV1TendersRisksJournalId(ctx echo.Context, _ string, _ youscore.GetV1TendersRisksJournalIdParams) error {
+	return s.handle(ctx, "GetV1TendersRisksJournalId")
+}
+
+func (s *Server) GetV1TendersStatuses(ctx echo.Context) error {
+	return s.handle(ctx, "GetV1TendersStatuses")
+}
+
+func (s *Server) GetV1UsrContractorCode(ctx echo.Context, _ string, _ youscore.GetV1UsrContractorCodeParams) error {
+	return s.handle(ctx, "GetV1UsrContractorCode")
+}
+
+func (s *Server) GetV1UsrAdministrativeServicesResultsCode(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1UsrAdministrativeServicesResultsCode")
+}
+
+func (s *Server) GetV1UsrDocumentsUsrOwnershipStructureFile(ctx echo.Context, _ youscore.GetV1UsrDocumentsUsrOwnershipStructureFileParams) error {
+	return s.handle(ctx, "GetV1UsrDocumentsUsrOwnershipStructureFile")
+}
+
+func (s *Server) GetV1UsrDocumentsUsrStatutFile(ctx echo.Context, _ youscore.GetV1UsrDocumentsUsrStatutFileParams) error {
+	return s.handle(ctx, "GetV1UsrDocumentsUsrStatutFile")
+}
+
+func (s *Server) GetV1VatContractorCode(ctx echo.Context, _ string, _ youscore.GetV1VatContractorCodeParams) error {
+	return s.handle(ctx, "GetV1VatContractorCode")
+}
+
+func (s *Server) GetV1VatCanceledContractorCode(ctx echo.Context, _ string, _ youscore.GetV1VatCanceledContractorCodeParams) error {
+	return s.handle(ctx, "GetV1VatCanceledContractorCode")
+}
+
+func (s *Server) GetV1VehiclesCheck(ctx echo.Context, _ youscore.GetV1VehiclesCheckParams) error {
+	return s.handle(ctx, "GetV1VehiclesCheck")
+}
+
+func (s *Server) GetV1VehiclesOwned(ctx echo.Context, _ youscore.GetV1VehiclesOwnedParams) error {
+	return s.handle(ctx, "GetV1VehiclesOwned")
+}
+
+func (s *Server) GetV1WantedOrDisappearedPersons(ctx echo.Context, _ youscore.GetV1WantedOrDisappearedPersonsParams) error {
+	return s.handle(ctx, "GetV1WantedOrDisappearedPersons")
+}
+
+func (s *Server) GetV1WantedOrDisappearedPersonsPhotosId(ctx echo.Context, _ string) error {
+	return s.handle(ctx, "GetV1WantedOrDisappearedPersonsPhotosId")
+}
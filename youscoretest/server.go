@@ -0,0 +1,378 @@
+// Package youscoretest provides a fake YouScore API for tests.
+//
+// The fake implements the generated youscore.ServerInterface and serves the examples
+// of the spec by default. Responses can be replaced per operation and contractor code,
+// async jobs can be made to stay in progress for a number of polls, and 429 responses
+// can be injected:
+//
+//	srv := youscoretest.NewServer(t)
+//	srv.SetFixture("GetV1UsrContractorCode", "08215600", youscoretest.Response{StatusCode: http.StatusNotFound})
+//	srv.SetAsyncPolls(2)
+//
+//	cl, err := srv.NewClient(youscore.WithAPIKeys(keys))
+package youscoretest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fritzkeyzer/goyouscore"
+	"github.com/labstack/echo/v4"
+)
+
+// Response is a response served by the Server.
+type Response struct {
+	StatusCode int
+	// Body is encoded as JSON, unless it is a []byte or string, which are sent as is.
+	Body   any
+	Header http.Header
+}
+
+// Request is a request received by the Server, see Server.Requests.
+type Request struct {
+	// Operation is the name of the operation, e.g. GetV1UsrContractorCode.
+	Operation string
+	Method    string
+	Path      string
+	Query     url.Values
+	Header    http.Header
+	Body      []byte
+	// Entity is the contractor code, INN or result id the request is about, if any.
+	Entity string
+	// StatusCode is the status the Server answered with.
+	StatusCode int
+	Time       time.Time
+}
+
+// Server is a fake YouScore API, backed by an httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	examples map[string]Response // by operation
+
+	mu          sync.Mutex
+	fixtures    map[fixtureKey]Response
+	pending     map[fixtureKey]int
+	asyncPolls  int
+	jobs        map[string]*job // by result id
+	nextJob     int
+	rateLimited int
+	retryAfter  time.Duration
+	requests    []Request
+}
+
+// fixtureKey identifies the responses of an operation, for one entity or for all ("").
+type fixtureKey struct {
+	operation string
+	entity    string
+}
+
+// job is an async job started through the Server.
+type job struct {
+	entity string
+	polls  int // polls left before the result is ready
+}
+
+// NewServer starts a Server, which is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	examples, err := specExamples()
+	if err != nil {
+		t.Fatal("youscoretest: load examples: ", err)
+	}
+	s := &Server{
+		examples: examples,
+		fixtures: make(map[fixtureKey]Response),
+		pending:  make(map[fixtureKey]int),
+		jobs:     make(map[string]*job),
+	}
+
+	e := echo.New()
+	e.HideBanner = true
+	youscore.RegisterHandlers(e, s)
+	s.Server = httptest.NewServer(e)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// NewClient returns a client for the Server. opts are applied after pointing the client at the Server.
+func (s *Server) NewClient(opts ...youscore.ClientOption) (*youscore.ClientWithResponses, error) {
+	return youscore.NewClientWithResponses(s.URL, opts...)
+}
+
+// SetFixture sets the response of an operation (e.g. "GetV1UsrContractorCode") for an entity:
+// a contractor code or INN. Results of async jobs use the entity of the request that started the job.
+// Results that were never started answer 404, unless they have a fixture for their result id.
+// An empty entity sets the response for all entities without a fixture of their own.
+func (s *Server) SetFixture(operation, entity string, resp Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[fixtureKey{operation, entity}] = resp
+}
+
+// SetPending makes the next n requests of an operation for an entity ("" for all) answer
+// 202 "Update in progress", before the regular response is served.
+func (s *Server) SetPending(operation, entity string, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[fixtureKey{operation, entity}] = n
+}
+
+// SetAsyncPolls makes async jobs started from now on answer 202 for n polls of their result,
+// before the result is served. Defaults to 0, results are ready immediately.
+func (s *Server) SetAsyncPolls(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.asyncPolls = n
+}
+
+// InjectTooManyRequests makes the next n requests answer 429, with the given Retry-After.
+func (s *Server) InjectTooManyRequests(n int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimited = n
+	s.retryAfter = retryAfter
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// RequestCount returns how many requests of an operation were received.
+func (s *Server) RequestCount(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := 0
+	for _, r := range s.requests {
+		if r.Operation == operation {
+			n++
+		}
+	}
+	return n
+}
+
+// handle serves every operation of the ServerInterface.
+func (s *Server) handle(ctx echo.Context, operation string) error {
+	req := ctx.Request()
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	op, _ := youscore.OperationByName(operation)
+
+	s.mu.Lock()
+	entity := s.entity(ctx, op, body)
+	resp := s.respond(ctx, op, entity)
+	s.requests = append(s.requests, Request{
+		Operation:  operation,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Query:      req.URL.Query(),
+		Header:     req.Header.Clone(),
+		Body:       body,
+		Entity:     entity,
+		StatusCode: resp.StatusCode,
+		Time:       time.Now(),
+	})
+	s.mu.Unlock()
+
+	return write(ctx, resp)
+}
+
+// respond picks the response of a request. s.mu must be held.
+func (s *Server) respond(ctx echo.Context, op youscore.Operation, entity string) Response {
+	if s.rateLimited > 0 {
+		s.rateLimited--
+		header := http.Header{"Retry-After": {strconv.Itoa(int(s.retryAfter.Seconds()))}}
+		return Response{StatusCode: http.StatusTooManyRequests, Header: header}
+	}
+
+	for _, key := range []fixtureKey{{op.Name, entity}, {op.Name, ""}} {
+		if s.pending[key] > 0 {
+			s.pending[key]--
+			return Response{StatusCode: http.StatusAccepted}
+		}
+	}
+
+	switch op.Async {
+	case youscore.AsyncStart:
+		if resp, ok := s.fixture(op.Name, entity); ok {
+			return resp
+		}
+		s.nextJob++
+		id := fmt.Sprintf("result-%d", s.nextJob)
+		s.jobs[id] = &job{entity: entity, polls: s.asyncPolls}
+		return Response{StatusCode: http.StatusAccepted, Body: map[string]string{resultField(op): id}}
+
+	case youscore.AsyncResult:
+		j, ok := s.jobs[resultID(ctx)]
+		if !ok {
+			// a result that was never started only exists as a fixture
+			if resp, ok := s.fixture(op.Name, entity); ok {
+				return resp
+			}
+			return Response{StatusCode: http.StatusNotFound}
+		}
+		if j.polls > 0 {
+			j.polls--
+			return Response{StatusCode: http.StatusAccepted}
+		}
+	}
+
+	if resp, ok := s.fixture(op.Name, entity); ok {
+		return resp
+	}
+	if resp, ok := s.examples[op.Name]; ok {
+		return resp
+	}
+	return Response{StatusCode: http.StatusOK, Body: map[string]any{}}
+}
+
+// fixture returns the fixture of an operation for the entity, or for all entities. s.mu must be held.
+func (s *Server) fixture(operation, entity string) (Response, bool) {
+	if resp, ok := s.fixtures[fixtureKey{operation, entity}]; ok {
+		return resp, true
+	}
+	resp, ok := s.fixtures[fixtureKey{operation, ""}]
+	return resp, ok
+}
+
+// entity returns the contractor code or INN a request is about. s.mu must be held.
+func (s *Server) entity(ctx echo.Context, op youscore.Operation, body []byte) string {
+	if op.Async == youscore.AsyncResult {
+		if j, ok := s.jobs[resultID(ctx)]; ok {
+			return j.entity
+		}
+		return resultID(ctx)
+	}
+
+	for _, v := range []string{
+		ctx.Param("contractorCode"),
+		ctx.QueryParam("contractorCode"),
+		ctx.QueryParam("INN"),
+		ctx.QueryParam("code"),
+		ctx.QueryParam("Code"),
+	} {
+		if v != "" {
+			return v
+		}
+	}
+
+	var query struct {
+		ContractorCode string `json:"contractorCode"`
+	}
+	if json.Unmarshal(body, &query) == nil {
+		return query.ContractorCode
+	}
+	return ""
+}
+
+// resultID returns the result id path parameter of an async result request.
+func resultID(ctx echo.Context) string {
+	for _, name := range []string{"resultId", "journalId", "id"} {
+		if v := ctx.Param(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// resultField returns the name of the field that carries the result id in the 202 of an async start,
+// which is the path parameter of the matching result operation.
+func resultField(start youscore.Operation) string {
+	prefix := strings.SplitN(strings.TrimPrefix(start.Path, "/v1/"), "/", 2)[0]
+	for _, op := range youscore.Operations() {
+		if op.Async != youscore.AsyncResult || !strings.HasPrefix(op.Path, "/v1/"+prefix+"/") {
+			continue
+		}
+		if param := path.Base(op.Path); strings.HasPrefix(param, "{") {
+			return strings.Trim(param, "{}")
+		}
+	}
+	return "resultId"
+}
+
+func write(ctx echo.Context, resp Response) error {
+	for k, vs := range resp.Header {
+		for _, v := range vs {
+			ctx.Response().Header().Add(k, v)
+		}
+	}
+
+	var body []byte
+	switch b := resp.Body.(type) {
+	case nil:
+		return ctx.NoContent(resp.StatusCode)
+	case []byte:
+		body = b
+	case string:
+		body = []byte(b)
+	default:
+		var err error
+		if body, err = json.Marshal(b); err != nil {
+			return err
+		}
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = echo.MIMEApplicationJSON
+	}
+	return ctx.Blob(resp.StatusCode, contentType, bytes.Clone(body))
+}
+
+// specExamples decodes the spec once, for all servers.
+var specExamples = sync.OnceValues(loadExamples)
+
+// loadExamples returns the example response of every operation of the spec, by operation name.
+func loadExamples() (map[string]Response, error) {
+	swagger, err := youscore.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	examples := make(map[string]Response)
+	for _, op := range youscore.Operations() {
+		item := swagger.Paths.Find(op.Path)
+		if item == nil {
+			continue
+		}
+		specOp := item.GetOperation(op.Method)
+		if specOp == nil || specOp.Responses == nil {
+			continue
+		}
+		for _, status := range []int{http.StatusOK, http.StatusAccepted} {
+			ref := specOp.Responses.Status(status)
+			if ref == nil || ref.Value == nil {
+				continue
+			}
+			media := ref.Value.Content.Get(echo.MIMEApplicationJSON)
+			if media == nil || media.Example == nil {
+				continue
+			}
+			body, err := json.Marshal(media.Example)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op.Name, err)
+			}
+			examples[op.Name] = Response{StatusCode: status, Body: body}
+			break
+		}
+	}
+	return examples, nil
+}
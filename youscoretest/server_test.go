@@ -0,0 +1,136 @@
+package youscoretest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fritzkeyzer/goyouscore"
+)
+
+func TestServer_Examples(t *testing.T) {
+	srv := NewServer(t)
+	cl, err := srv.NewClient(youscore.WithAPIKeys(youscore.APIKeys{DataAnalytics: "da-key"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	usr, err := youscore.Result(cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usr.Code == nil || *usr.Code != "39404434" {
+		t.Fatalf("expected the spec example, got %+v", usr.Code)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 1 || reqs[0].Operation != "GetV1UsrContractorCode" || reqs[0].Entity != "08215600" {
+		t.Fatalf("unexpected request log: %+v", reqs)
+	}
+	if got := reqs[0].Header.Get("Authorization"); got != "bearer da-key" {
+		t.Fatalf("expected the API key to be logged, got %q", got)
+	}
+}
+
+func TestServer_Fixtures(t *testing.T) {
+	srv := NewServer(t)
+	srv.SetFixture("GetV1UsrContractorCode", "00000000", Response{StatusCode: http.StatusNotFound})
+	srv.SetFixture("GetV1UsrContractorCode", "11111111", Response{StatusCode: http.StatusOK, Body: `{"code":"11111111"}`})
+	cl, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := t.Context()
+
+	if _, err := youscore.Result(cl.GetV1UsrContractorCodeWithResponse(ctx, "00000000", nil)); err == nil {
+		t.Fatal("expected not found")
+	}
+	usr, err := youscore.Result(cl.GetV1UsrContractorCodeWithResponse(ctx, "11111111", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *usr.Code != "11111111" {
+		t.Fatalf("expected the fixture, got %q", *usr.Code)
+	}
+
+	// in progress, then the example
+	srv.SetPending("GetV1UsrContractorCode", "", 1)
+	res, err := cl.GetV1UsrContractorCodeWithResponse(ctx, "08215600", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", res.StatusCode())
+	}
+	if _, err := youscore.Result(cl.GetV1UsrContractorCodeWithResponse(ctx, "08215600", nil)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_Async(t *testing.T) {
+	srv := NewServer(t)
+	srv.SetAsyncPolls(2)
+	srv.SetFixture("GetV1IndividualsRnboSanctionsResultIdExtended", "1234567890", Response{
+		StatusCode: http.StatusOK,
+		Body:       `{"data":[{}]}`,
+	})
+	cl, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	poller := youscore.NewPoller(cl, youscore.WithPollBackoff(time.Millisecond, time.Millisecond, 1))
+	inn := "1234567890"
+	res, err := poller.IndividualsRnboSanctions(t.Context(), &youscore.GetV1IndividualsRnboSanctionsParams{INN: &inn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Data == nil || len(*res.Data) != 1 {
+		t.Fatalf("expected the fixture of the INN, got %+v", res)
+	}
+	if got := srv.RequestCount("GetV1IndividualsRnboSanctionsResultIdExtended"); got != 3 {
+		t.Fatalf("expected 2 pending polls and the result, got %d polls", got)
+	}
+}
+
+func TestServer_UnknownResult(t *testing.T) {
+	srv := NewServer(t)
+	srv.SetFixture("GetV1CorruptedPersonsResultId", "known", Response{StatusCode: http.StatusOK, Body: `{}`})
+	cl, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cl.GetV1CorruptedPersonsResultIdWithResponse(t.Context(), "unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusNotFound {
+		t.Fatalf("expected 404 for a result that was never started, got %d", res.StatusCode())
+	}
+
+	res, err = cl.GetV1CorruptedPersonsResultIdWithResponse(t.Context(), "known")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusOK {
+		t.Fatalf("expected the fixture of the result id, got %d", res.StatusCode())
+	}
+}
+
+func TestServer_TooManyRequests(t *testing.T) {
+	srv := NewServer(t)
+	srv.InjectTooManyRequests(2, 0)
+	cl, err := srv.NewClient(youscore.WithRetry(youscore.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := youscore.Result(cl.GetV1UsrContractorCodeWithResponse(t.Context(), "08215600", nil)); err != nil {
+		t.Fatal(err)
+	}
+	reqs := srv.Requests()
+	if len(reqs) != 3 || reqs[0].StatusCode != http.StatusTooManyRequests || reqs[2].StatusCode != http.StatusOK {
+		t.Fatalf("unexpected request log: %+v", reqs)
+	}
+}